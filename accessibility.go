@@ -0,0 +1,56 @@
+package chart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessibleRenderer is an optional extension of Renderer for output formats
+// that can carry accessibility metadata alongside the drawing, such as SVG.
+// Charts check for it with a type assertion, so renderers that don't
+// implement it are unaffected.
+type AccessibleRenderer interface {
+	// SetTitle sets the accessible title of the image.
+	SetTitle(title string)
+
+	// SetDescription sets the accessible description of the image.
+	SetDescription(description string)
+
+	// AddDataTable adds a table of values that is hidden visually but
+	// exposed to screen readers.
+	AddDataTable(table DataTable)
+}
+
+// DataTable is a table of the values drawn by a chart.
+type DataTable struct {
+	Caption string
+	Headers []string
+	Rows    [][]string
+}
+
+// setAccessibleText sets the title and description if the renderer supports them.
+func setAccessibleText(r Renderer, title, description string) {
+	if ar, isAccessible := unwrapRenderer(r).(AccessibleRenderer); isAccessible {
+		ar.SetTitle(title)
+		ar.SetDescription(description)
+	}
+}
+
+// addDataTable adds a data table if the renderer supports it.
+func addDataTable(r Renderer, table DataTable) {
+	if ar, isAccessible := unwrapRenderer(r).(AccessibleRenderer); isAccessible {
+		ar.AddDataTable(table)
+	}
+}
+
+// describeItems returns a one sentence summary, e.g. "Bar chart with 2 bars: a, b."
+func describeItems(kind, singular, plural string, labels []string) string {
+	noun := plural
+	if len(labels) == 1 {
+		noun = singular
+	}
+	if len(labels) == 0 {
+		return fmt.Sprintf("%s with no %s.", kind, plural)
+	}
+	return fmt.Sprintf("%s with %d %s: %s.", kind, len(labels), noun, strings.Join(labels, ", "))
+}
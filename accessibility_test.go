@@ -0,0 +1,140 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+// assertWellFormedXML fails the test if the document is not well formed xml.
+func assertWellFormedXML(t *testing.T, document string) {
+	t.Helper()
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return
+		}
+		testutil.AssertNil(t, err)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func TestChartSVGEscaping(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Title: `<script>alert("x")</script> & co`,
+		Series: []Series{
+			ContinuousSeries{
+				Name:    `Tom's <b>series</b>`,
+				Style:   Style{ClassName: `a"b`},
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVGWithCSS(".x{}]]><script/>", `n"once`), buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertNotContains(t, raw, "<script>")
+	testutil.AssertNotContains(t, raw, "<b>")
+	testutil.AssertContains(t, raw, "&lt;script&gt;alert(&quot;x&quot;)&lt;/script&gt; &amp; co")
+	testutil.AssertContains(t, raw, "Tom&#39;s &lt;b&gt;series&lt;/b&gt;")
+	testutil.AssertContains(t, raw, `class="a&quot;b`)
+}
+
+func TestChartSVGAccessibility(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Title: "Test",
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "Foo",
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{3, 2, 1},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertTrue(t, strings.HasPrefix(raw, "<svg"))
+	testutil.AssertContains(t, raw, `height="400" role="img">`)
+	testutil.AssertContains(t, raw, "<title>Test</title><desc>Chart with 2 series: Foo, Series 2.</desc>")
+	testutil.AssertContains(t, raw, `role="group" aria-label="Foo">`)
+	testutil.AssertContains(t, raw, `role="group" aria-label="Series 2">`)
+	testutil.AssertNotContains(t, raw, "<table")
+
+	c.Description = "Custom"
+	c.DataTable = true
+	c.Limits = RenderLimits{MaxPathCommands: 1 << 16}
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	raw = buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertContains(t, raw, `role="figure">`)
+	testutil.AssertContains(t, raw, "<desc>Custom</desc>")
+	testutil.AssertEqual(t, 2, strings.Count(raw, "<table"))
+	testutil.AssertContains(t, raw, `<caption>Foo</caption><thead><tr><th scope="col">X</th><th scope="col">Y</th></tr></thead>`)
+	testutil.AssertContains(t, raw, "<tr><td>1.00</td><td>3.00</td></tr>")
+}
+
+func TestBarChartSVGAccessibility(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Title:     "Bars & Stripes",
+		DataTable: true,
+		Bars: []Value{
+			{Value: 1, Label: "<One>"},
+			{Value: 2, Label: "Two"},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, bc.Render(SVG, buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertContains(t, raw, "<title>Bars &amp; Stripes</title><desc>Bar chart with 2 bars: &lt;One&gt;, Two.</desc>")
+	testutil.AssertContains(t, raw, "<tr><td>&lt;One&gt;</td><td>1.00</td></tr>")
+}
+
+func TestPieChartSVGAccessibility(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PieChart{
+		Title: "Pie",
+		Values: []Value{
+			{Value: 1, Label: "A"},
+			{Value: 1, Label: "B"},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pc.Render(SVG, buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertContains(t, raw, "<title>Pie</title><desc>Pie chart with 2 slices: A, B.</desc>")
+}
@@ -0,0 +1,180 @@
+package chart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*AnnotationSeries)(nil)
+	_ ValuesProvider = (*AnnotationSeries)(nil)
+	_ MeasuredSeries = (*AnnotationSeries)(nil)
+)
+
+// MeasuredSeries is a series that draws outside of its values, such as the
+// callouts of annotations, and measures the box it draws in so the chart can
+// shrink its canvas to fit it.
+type MeasuredSeries interface {
+	Series
+	Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box
+}
+
+// AnnotationSeries is a series of labeled callouts at data coordinates.
+//
+// The annotated points count towards the chart's ranges, and the chart
+// shrinks its canvas so the callouts are not clipped. Callouts that would
+// overlap are nudged down, with a leader line back to their point.
+type AnnotationSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// PinToYAxis places the callouts at the edge of the canvas on the side
+	// of the series' y axis rather than at their x values.
+	PinToYAxis bool
+
+	Annotations []Value2
+}
+
+// GetName returns the name of the series.
+func (as AnnotationSeries) GetName() string {
+	return as.Name
+}
+
+// GetStyle returns the series style.
+func (as AnnotationSeries) GetStyle() Style {
+	return as.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (as AnnotationSeries) GetYAxis() YAxisType {
+	return as.YAxis
+}
+
+// Len returns the number of annotations.
+func (as AnnotationSeries) Len() int {
+	return len(as.Annotations)
+}
+
+// GetValues gets the x,y values of the annotation at a given index.
+func (as AnnotationSeries) GetValues(index int) (x, y float64) {
+	return as.Annotations[index].XValue, as.Annotations[index].YValue
+}
+
+// Measure returns the bounds of the callouts, including their points.
+func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box {
+	box := Box{
+		Top:    canvasBox.Bottom,
+		Left:   canvasBox.Right,
+		Right:  canvasBox.Left,
+		Bottom: canvasBox.Top,
+	}
+	if as.Style.Hidden {
+		return box
+	}
+	for _, callout := range as.layout(r, canvasBox, xrange, yrange, defaults) {
+		box = box.Grow(callout.box).Grow(Box{Top: callout.y, Left: callout.x, Right: callout.x, Bottom: callout.y})
+	}
+	return box
+}
+
+// Render draws the series.
+func (as AnnotationSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if as.Style.Hidden {
+		return
+	}
+	for _, callout := range as.layout(r, canvasBox, xrange, yrange, defaults) {
+		if callout.labelY != callout.y {
+			callout.style.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(callout.x, callout.y)
+			r.LineTo(callout.x, callout.labelY)
+			r.Stroke()
+			r.ResetStyle()
+		}
+		Draw.Annotation(r, canvasBox, callout.style, callout.x, callout.labelY, callout.label)
+	}
+}
+
+// annotationCallout is an annotation placed on the canvas.
+type annotationCallout struct {
+	style  Style
+	label  string
+	x, y   int
+	labelY int
+	box    Box
+}
+
+// layout places the annotations at their points, then nudges each one down
+// past any callout above it that it overlaps, in order from the top.
+func (as AnnotationSeries) layout(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) []annotationCallout {
+	seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
+
+	var callouts []annotationCallout
+	for _, a := range as.Annotations {
+		style := a.Style.InheritFrom(seriesStyle)
+		if a.Style.Hidden || len(a.Label) == 0 {
+			continue
+		}
+		x := canvasBox.Left + xrange.Translate(a.XValue)
+		if as.PinToYAxis {
+			if as.YAxis == YAxisSecondary {
+				x = canvasBox.Left
+			} else {
+				x = canvasBox.Right
+			}
+		}
+		y := canvasBox.Bottom - yrange.Translate(a.YValue)
+		callouts = append(callouts, annotationCallout{
+			style:  style,
+			label:  a.Label,
+			x:      x,
+			y:      y,
+			labelY: y,
+			box:    Draw.MeasureAnnotation(r, canvasBox, style, x, y, a.Label),
+		})
+	}
+
+	sort.SliceStable(callouts, func(i, j int) bool {
+		if callouts[i].y == callouts[j].y {
+			return callouts[i].x < callouts[j].x
+		}
+		return callouts[i].y < callouts[j].y
+	})
+
+	for i := range callouts {
+		// callouts only move down, so each placed one is passed at most once.
+		for moved := true; moved; {
+			moved = false
+			for _, placed := range callouts[:i] {
+				if callouts[i].box.Intersects(placed.box) {
+					shift := placed.box.Bottom - callouts[i].box.Top + DefaultAnnotationSpacing
+					callouts[i].box = callouts[i].box.Shift(0, shift)
+					callouts[i].labelY += shift
+					moved = true
+				}
+			}
+		}
+	}
+	return callouts
+}
+
+func (as AnnotationSeries) annotationStyleDefaults(defaults Style) Style {
+	return Style{
+		FontColor:   DefaultTextColor,
+		Font:        defaults.Font,
+		FillColor:   DefaultAnnotationFillColor,
+		FontSize:    DefaultAnnotationFontSize,
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: defaults.StrokeWidth,
+		Padding:     DefaultAnnotationPadding,
+	}
+}
+
+// Validate validates the series.
+func (as AnnotationSeries) Validate() error {
+	if len(as.Annotations) == 0 {
+		return fmt.Errorf("annotation series requires annotations to be set and not empty")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testAnnotationSeries() AnnotationSeries {
+	return AnnotationSeries{
+		Annotations: []Value2{
+			{XValue: 1, YValue: 1, Label: "first"},
+			{XValue: 1, YValue: 1, Label: "second"},
+			{XValue: 1, YValue: 1, Label: "third"},
+			{XValue: 9, YValue: 9, Label: "apart"},
+			{XValue: 5, YValue: 5, Style: Style{Hidden: true}, Label: "hidden"},
+			{XValue: 5, YValue: 5},
+		},
+	}
+}
+
+func TestAnnotationSeriesContributesToRanges(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+			AnnotationSeries{
+				Annotations: []Value2{
+					{XValue: 5, YValue: 10, Label: "peak"},
+				},
+			},
+		},
+	}
+
+	xrange, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 5.0, xrange.GetMax())
+	testutil.AssertEqual(t, 10.0, yrange.GetMax())
+}
+
+func TestAnnotationSeriesLayout(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := SVG(200, 200)
+	testutil.AssertNil(t, err)
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 200}
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 200}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 200}
+
+	callouts := testAnnotationSeries().layout(r, canvasBox, xrange, yrange, Style{Font: f})
+	// hidden and unlabeled annotations are skipped.
+	testutil.AssertLen(t, callouts, 4)
+	for i := range callouts {
+		for j := i + 1; j < len(callouts); j++ {
+			testutil.AssertFalse(t, callouts[i].box.Intersects(callouts[j].box), callouts[i].label, callouts[j].label)
+		}
+	}
+
+	labelYs := map[string]int{}
+	for _, callout := range callouts {
+		labelYs[callout.label] = callout.labelY
+	}
+	// the first callout stays at its point, the others are nudged down in turn.
+	testutil.AssertEqual(t, 180, labelYs["first"])
+	testutil.AssertTrue(t, labelYs["second"] > labelYs["first"])
+	testutil.AssertTrue(t, labelYs["third"] > labelYs["second"])
+	testutil.AssertEqual(t, 20, labelYs["apart"])
+}
+
+func TestAnnotationSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  200,
+		Height: 200,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 10},
+				YValues: []float64{0, 10},
+			},
+			testAnnotationSeries(),
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	svg := buffer.String()
+	testutil.AssertContains(t, svg, ">second</text>")
+	testutil.AssertNotContains(t, svg, ">hidden</text>")
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestAnnotationSeriesAdjustsCanvas(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(200, 200)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r.SetFont(f)
+
+	c := Chart{
+		Width:  200,
+		Height: 200,
+		Series: []Series{
+			// the canvas is adjusted for annotation series behind a pointer too.
+			&AnnotationSeries{
+				Annotations: []Value2{
+					{XValue: 10, YValue: 0, Label: "a long label at the bottom right"},
+				},
+			},
+			ContinuousSeries{
+				XValues: []float64{0, 10},
+				YValues: []float64{0, 10},
+			},
+		},
+	}
+	testutil.AssertTrue(t, c.hasAnnotationSeries())
+
+	canvasBox := c.getDefaultCanvasBox()
+	xr, yr, yra := c.getRanges()
+	xr, yr, yra = setRangeDomains(canvasBox, xr, yr, yra)
+
+	adjusted := c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra)
+	testutil.AssertTrue(t, adjusted.Right < canvasBox.Right)
+	testutil.AssertTrue(t, adjusted.Bottom <= canvasBox.Bottom)
+
+	xr, yr, _ = setRangeDomains(adjusted, xr, yr, yra)
+	measured := c.Series[0].(MeasuredSeries).Measure(r, adjusted, xr, yr, c.styleDefaultsSeries(0))
+	testutil.AssertTrue(t, measured.Right <= c.Box().Right, measured.Right, c.Box().Right)
+}
+
+func TestAnnotationSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, AnnotationSeries{}.Validate())
+	testutil.AssertNil(t, testAnnotationSeries().Validate())
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +15,13 @@ type BarChart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is the accessible description of the chart, for renderers
+	// that support it (SVG). It defaults to a summary of the bars.
+	Description string
+	// DataTable adds a visually hidden table of the bar values for screen
+	// readers, for renderers that support it (SVG).
+	DataTable bool
+
 	ColorPalette ColorPalette
 
 	Width  int
@@ -30,6 +38,10 @@ type BarChart struct {
 
 	BarSpacing int
 
+	// IsHorizontal lays out the bars horizontally, with the bar labels on the
+	// left axis and the value axis along the bottom.
+	IsHorizontal bool
+
 	UseBaseValue bool
 	BaseValue    float64
 
@@ -38,6 +50,9 @@ type BarChart struct {
 
 	Bars     []Value
 	Elements []Renderable
+
+	// Limits are resource limits enforced by Render and RenderContext.
+	Limits RenderLimits
 }
 
 // GetDPI returns the dpi for the chart.
@@ -90,14 +105,29 @@ func (bc BarChart) GetBarWidth() int {
 
 // Render renders the chart with the given renderer to the given io.Writer.
 func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
+	return bc.RenderContext(context.Background(), rp, w)
+}
+
+// RenderContext renders the chart with the given renderer to the given io.Writer.
+// It stops rendering and returns the context error if the context is done, and
+// returns a *LimitError if rendering would exceed the chart's Limits.
+func (bc BarChart) RenderContext(ctx context.Context, rp RendererProvider, w io.Writer) error {
 	if len(bc.Bars) == 0 {
 		return errors.New("please provide at least one bar")
 	}
+	if err := bc.Limits.CheckCanvas(bc.GetWidth(), bc.GetHeight()); err != nil {
+		return err
+	}
+	if err := bc.Limits.CheckSeriesPoints("", len(bc.Bars)); err != nil {
+		return err
+	}
 
 	r, err := rp(bc.GetWidth(), bc.GetHeight())
 	if err != nil {
 		return err
 	}
+	r, lr := bc.Limits.wrap(r)
+	setAccessibleText(r, bc.Title, bc.getDescription())
 
 	if bc.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -128,19 +158,80 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 		canvasBox = bc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
 		yr = bc.setRangeDomains(canvasBox, yr)
 	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
 	bc.drawCanvas(r, canvasBox)
-	bc.drawBars(r, canvasBox, yr)
-	bc.drawXAxis(r, canvasBox)
-	bc.drawYAxis(r, canvasBox, yr, yt)
+	if bc.IsHorizontal {
+		err = bc.drawBarsHorizontal(ctx, r, canvasBox, yr)
+	} else {
+		err = bc.drawBars(ctx, r, canvasBox, yr)
+	}
+	if err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	if bc.IsHorizontal {
+		bc.drawXAxisHorizontal(r, canvasBox)
+	} else {
+		bc.drawXAxis(r, canvasBox)
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	if bc.IsHorizontal {
+		bc.drawYAxisHorizontal(r, canvasBox, yr, yt)
+	} else {
+		bc.drawYAxis(r, canvasBox, yr, yt)
+	}
+	if err = lr.Err(); err != nil {
+		return err
+	}
 
 	bc.drawTitle(r)
 	for _, a := range bc.Elements {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
 		a(r, canvasBox, bc.styleDefaultsElements())
 	}
+	if err = lr.Err(); err != nil {
+		return err
+	}
 
+	if bc.DataTable {
+		bc.addDataTable(r, yf)
+	}
 	return r.Save(w)
 }
 
+// getDescription returns the description or a summary of the bars.
+func (bc BarChart) getDescription() string {
+	if len(bc.Description) > 0 {
+		return bc.Description
+	}
+	labels := make([]string, 0, len(bc.Bars))
+	for _, bar := range bc.Bars {
+		labels = append(labels, bar.Label)
+	}
+	return describeItems("Bar chart", "bar", "bars", labels)
+}
+
+// addDataTable adds a table of the bar labels and values.
+func (bc BarChart) addDataTable(r Renderer, yf ValueFormatter) {
+	table := DataTable{
+		Caption: bc.Title,
+		Headers: []string{"Label", "Value"},
+	}
+	for _, bar := range bc.Bars {
+		table.Rows = append(table.Rows, []string{bar.Label, yf(bar.Value)})
+	}
+	addDataTable(r, table)
+}
+
 func (bc BarChart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, bc.getCanvasStyle())
 }
@@ -187,7 +278,7 @@ func (bc BarChart) drawBackground(r Renderer) {
 	}, bc.getBackgroundStyle())
 }
 
-func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
+func (bc BarChart) drawBars(ctx context.Context, r Renderer, canvasBox Box, yr Range) error {
 	xoffset := canvasBox.Left
 
 	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
@@ -196,6 +287,9 @@ func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 	var barBox Box
 	var bxl, bxr, by int
 	for index, bar := range bc.Bars {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		bxl = xoffset + bs2
 		bxr = bxl + width
 
@@ -218,9 +312,72 @@ func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 		}
 
 		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
+		bc.drawHitTarget(r, barBox, bar)
 
 		xoffset += width + spacing
 	}
+	return nil
+}
+
+func (bc BarChart) drawBarsHorizontal(ctx context.Context, r Renderer, canvasBox Box, yr Range) error {
+	yoffset := canvasBox.Top
+
+	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+	bs2 := spacing >> 1
+
+	base := canvasBox.Left
+	if bc.UseBaseValue {
+		base = canvasBox.Left + yr.Translate(bc.BaseValue)
+	}
+
+	var barBox Box
+	var byt, byb, bx int
+	for index, bar := range bc.Bars {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		byt = yoffset + bs2
+		byb = byt + width
+
+		bx = canvasBox.Left + yr.Translate(bar.Value)
+
+		barBox = Box{
+			Top:    byt,
+			Left:   MinInt(base, bx),
+			Right:  MaxInt(base, bx),
+			Bottom: byb,
+		}
+
+		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
+		bc.drawHitTarget(r, barBox, bar)
+
+		yoffset += width + spacing
+	}
+	return nil
+}
+
+// drawHitTarget draws a hit target over a bar if the renderer supports them.
+func (bc BarChart) drawHitTarget(r Renderer, barBox Box, bar Value) {
+	htr, ok := getHitTargetRenderer(r)
+	if !ok {
+		return
+	}
+	value := bc.getValueFormatters()(bar.Value)
+	tooltip := value
+	if len(bar.Label) > 0 {
+		tooltip = bar.Label + ": " + value
+	}
+	htr.HitTarget(HitTarget{
+		Box: Box{
+			Top:    MinInt(barBox.Top, barBox.Bottom),
+			Left:   barBox.Left,
+			Right:  barBox.Right,
+			Bottom: MaxInt(barBox.Top, barBox.Bottom),
+		},
+		XValue:  bar.Label,
+		YValue:  value,
+		Tooltip: tooltip,
+	})
 }
 
 func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
@@ -262,6 +419,52 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 	}
 }
 
+func (bc BarChart) drawXAxisHorizontal(r Renderer, canvasBox Box) {
+	if !bc.XAxis.Hidden {
+		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+
+		labelStyle := bc.XAxis.InheritFrom(Style{
+			TextHorizontalAlign: TextHorizontalAlignRight,
+			TextVerticalAlign:   TextVerticalAlignMiddle,
+		}).InheritFrom(axisStyle)
+
+		cursor := canvasBox.Top
+		for index, bar := range bc.Bars {
+			barLabelBox := Box{
+				Top:    cursor,
+				Left:   bc.box().Left,
+				Right:  canvasBox.Left - DefaultYAxisMargin,
+				Bottom: cursor + width + spacing,
+			}
+
+			if len(bar.Label) > 0 {
+				Draw.TextWithin(r, bar.Label, barLabelBox, labelStyle)
+			}
+
+			axisStyle.WriteToRenderer(r)
+			if index < len(bc.Bars)-1 {
+				r.MoveTo(canvasBox.Left, barLabelBox.Bottom)
+				r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, barLabelBox.Bottom)
+				r.Stroke()
+			}
+			cursor += width + spacing
+		}
+	}
+}
+
+func (bc BarChart) drawYAxisHorizontal(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if !bc.YAxis.Style.Hidden {
+		valueAxisAsXAxis(bc.YAxis).Render(r, canvasBox, yr, bc.styleDefaultsAxes(), ticks)
+	}
+}
+
 func (bc BarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
 	if !bc.YAxis.Style.Hidden {
 		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
@@ -327,8 +530,12 @@ func (bc BarChart) hasAxes() bool {
 	return !bc.YAxis.Style.Hidden
 }
 
-func (BarChart) setRangeDomains(canvasBox Box, yr Range) Range {
-	yr.SetDomain(canvasBox.Height())
+func (bc BarChart) setRangeDomains(canvasBox Box, yr Range) Range {
+	if bc.IsHorizontal {
+		yr.SetDomain(canvasBox.Width())
+	} else {
+		yr.SetDomain(canvasBox.Height())
+	}
 	return yr
 }
 
@@ -345,15 +552,27 @@ func (bc BarChart) getValueFormatters() ValueFormatter {
 
 func (bc BarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks []Tick) {
 	if !bc.YAxis.Style.Hidden {
-		yticks = bc.YAxis.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		if bc.IsHorizontal {
+			yticks = valueAxisAsXAxis(bc.YAxis).GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		} else {
+			yticks = bc.YAxis.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		}
 	}
 	return
 }
 
+// getCategoryAxisLength returns the pixels available to lay out the bars.
+func (bc BarChart) getCategoryAxisLength(canvasBox Box) int {
+	if bc.IsHorizontal {
+		return canvasBox.Height()
+	}
+	return canvasBox.Width()
+}
+
 func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 	totalWithBaseSpacing := bc.calculateTotalBarWidth(bc.GetBarWidth(), bc.GetBarSpacing())
-	if totalWithBaseSpacing > canvasBox.Width() {
-		lessBarWidths := canvasBox.Width() - (len(bc.Bars) * bc.GetBarWidth())
+	if totalWithBaseSpacing > bc.getCategoryAxisLength(canvasBox) {
+		lessBarWidths := bc.getCategoryAxisLength(canvasBox) - (len(bc.Bars) * bc.GetBarWidth())
 		if lessBarWidths > 0 {
 			return int(math.Ceil(float64(lessBarWidths) / float64(len(bc.Bars))))
 		}
@@ -364,8 +583,8 @@ func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 
 func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
 	totalWithBaseWidth := bc.calculateTotalBarWidth(bc.GetBarWidth(), spacing)
-	if totalWithBaseWidth > canvasBox.Width() {
-		totalLessBarSpacings := canvasBox.Width() - (len(bc.Bars) * spacing)
+	if totalWithBaseWidth > bc.getCategoryAxisLength(canvasBox) {
+		totalLessBarSpacings := bc.getCategoryAxisLength(canvasBox) - (len(bc.Bars) * spacing)
 		if totalLessBarSpacings > 0 {
 			return int(math.Ceil(float64(totalLessBarSpacings) / float64(len(bc.Bars))))
 		}
@@ -386,6 +605,10 @@ func (bc BarChart) calculateScaledTotalWidth(canvasBox Box) (width, spacing, tot
 }
 
 func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	if bc.IsHorizontal {
+		return bc.getAdjustedCanvasBoxHorizontal(r, canvasBox, yrange, yticks)
+	}
+
 	axesOuterBox := canvasBox.Clone()
 
 	_, _, totalWidth := bc.calculateScaledTotalWidth(canvasBox)
@@ -430,6 +653,35 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 	return canvasBox.OuterConstrain(bc.box(), axesOuterBox)
 }
 
+func (bc BarChart) getAdjustedCanvasBoxHorizontal(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	axesOuterBox := canvasBox.Clone()
+
+	if !bc.XAxis.Hidden {
+		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
+
+		var labelWidth int
+		for _, bar := range bc.Bars {
+			if len(bar.Label) > 0 {
+				labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, bar.Label, axisStyle).Width())
+			}
+		}
+
+		axesOuterBox = axesOuterBox.Grow(Box{
+			Top:    canvasBox.Top,
+			Left:   canvasBox.Left - (labelWidth + 1 + DefaultYAxisMargin), // text wraps when it exactly fills its box.
+			Right:  canvasBox.Right,
+			Bottom: canvasBox.Bottom,
+		})
+	}
+
+	if !bc.YAxis.Style.Hidden {
+		axesBounds := valueAxisAsXAxis(bc.YAxis).Measure(r, canvasBox, yrange, bc.styleDefaultsAxes(), yticks)
+		axesOuterBox = axesOuterBox.Grow(axesBounds)
+	}
+
+	return canvasBox.OuterConstrain(bc.box(), axesOuterBox)
+}
+
 // box returns the chart bounds as a box.
 func (bc BarChart) box() Box {
 	dpr := bc.Background.Padding.GetRight(10)
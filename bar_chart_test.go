@@ -29,6 +29,33 @@ func TestBarChartRender(t *testing.T) {
 	testutil.AssertNotZero(t, buf.Len())
 }
 
+func TestBarChartRenderHorizontal(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Width:        1024,
+		Title:        "Test Title",
+		IsHorizontal: true,
+		UseBaseValue: true,
+		BaseValue:    0.0,
+		Bars: []Value{
+			{Value: -1.0, Label: "Minus One"},
+			{Value: 2.0, Label: "Two"},
+			{Value: 3.0, Label: "A much longer label for three"},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err := bc.Render(PNG, buf)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, buf.Len())
+
+	buf.Reset()
+	err = bc.Render(SVG, buf)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, buf.Len())
+}
+
 func TestBarChartRenderZero(t *testing.T) {
 	// replaced new assertions helper
 
@@ -198,6 +225,19 @@ func TestBarChartSetRangeDomains(t *testing.T) {
 	testutil.AssertNotZero(t, yr2.GetDomain())
 }
 
+func TestBarChartSetRangeDomainsHorizontal(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{IsHorizontal: true}
+	cb := bc.box()
+	yr := bc.setRangeDomains(cb, bc.getRanges())
+	testutil.AssertEqual(t, cb.Width(), yr.GetDomain())
+
+	bc.IsHorizontal = false
+	yr = bc.setRangeDomains(cb, bc.getRanges())
+	testutil.AssertEqual(t, cb.Height(), yr.GetDomain())
+}
+
 func TestBarChartGetValueFormatters(t *testing.T) {
 	// replaced new assertions helper
 
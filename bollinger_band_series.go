@@ -0,0 +1,154 @@
+package chart
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultBollingerBandK is the default number of standard deviations
+	// the bands are drawn away from the moving average.
+	DefaultBollingerBandK = 2.0
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*BollingerBandsSeries)(nil)
+	_ BoundedValuesProvider     = (*BollingerBandsSeries)(nil)
+	_ BoundedLastValuesProvider = (*BollingerBandsSeries)(nil)
+	_ FirstValuesProvider       = (*BollingerBandsSeries)(nil)
+	_ LastValuesProvider        = (*BollingerBandsSeries)(nil)
+)
+
+// BollingerBandsSeries draws bollinger bands for an inner series.
+// Bollinger bands are defined by two lines, one at SMA+k*stddev, one at SMA-k*stdev.
+type BollingerBandsSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	K           float64
+	InnerSeries ValuesProvider
+}
+
+// GetName returns the name of the time series.
+func (bbs BollingerBandsSeries) GetName() string {
+	return bbs.Name
+}
+
+// GetStyle returns the line style.
+func (bbs BollingerBandsSeries) GetStyle() Style {
+	return bbs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bbs BollingerBandsSeries) GetYAxis() YAxisType {
+	return bbs.YAxis
+}
+
+// GetPeriod returns the window size.
+func (bbs BollingerBandsSeries) GetPeriod() int {
+	if bbs.Period == 0 {
+		return DefaultSimpleMovingAveragePeriod
+	}
+	return bbs.Period
+}
+
+// GetK returns the K value, or the number of standard deviations above and below
+// to band the simple moving average with.
+// Typical K value is 2.0.
+func (bbs BollingerBandsSeries) GetK(defaults ...float64) float64 {
+	if bbs.K == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultBollingerBandK
+	}
+	return bbs.K
+}
+
+// Len returns the length of the series.
+func (bbs BollingerBandsSeries) Len() int {
+	return bbs.InnerSeries.Len()
+}
+
+// GetBoundedValues gets the bounded value for the series.
+func (bbs BollingerBandsSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	x, _ = bbs.InnerSeries.GetValues(index)
+	y1, y2 = bbs.getBands(index)
+	return
+}
+
+// GetBoundedLastValues returns the last bounded value for the series.
+func (bbs BollingerBandsSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	return bbs.GetBoundedValues(bbs.InnerSeries.Len() - 1)
+}
+
+// GetFirstValues returns the first value of the moving average the bands surround.
+func (bbs BollingerBandsSeries) GetFirstValues() (x, y float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	x, _ = bbs.InnerSeries.GetValues(0)
+	y = Seq{bbs.getWindow(0)}.Average()
+	return
+}
+
+// GetLastValues returns the last value of the moving average the bands surround.
+func (bbs BollingerBandsSeries) GetLastValues() (x, y float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	lastIndex := bbs.InnerSeries.Len() - 1
+	x, _ = bbs.InnerSeries.GetValues(lastIndex)
+	y = Seq{bbs.getWindow(lastIndex)}.Average()
+	return
+}
+
+// getBands returns the upper and lower band for the window ending at the given index.
+func (bbs BollingerBandsSeries) getBands(index int) (upper, lower float64) {
+	window := Seq{bbs.getWindow(index)}
+	ay := window.Average()
+	std := window.StdDev()
+
+	upper = ay + (bbs.GetK() * std)
+	lower = ay - (bbs.GetK() * std)
+	return
+}
+
+// getWindow returns the (up to) `period` inner values ending at the given index.
+func (bbs BollingerBandsSeries) getWindow(index int) *ValueBuffer {
+	period := bbs.GetPeriod()
+	floor := MaxInt(0, index-period+1)
+	window := NewValueBufferWithCapacity(period)
+	for x := floor; x <= index; x++ {
+		_, vy := bbs.InnerSeries.GetValues(x)
+		window.Enqueue(vy)
+	}
+	return window
+}
+
+// Render renders the series.
+func (bbs BollingerBandsSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	s := bbs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: DefaultAxisColor.WithAlpha(64),
+		FillColor:   DefaultAxisColor.WithAlpha(32),
+	}))
+
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, s, bbs, bbs.GetPeriod()-1)
+}
+
+// Validate validates the series.
+func (bbs BollingerBandsSeries) Validate() error {
+	if bbs.InnerSeries == nil {
+		return fmt.Errorf("bollinger bands series requires InnerSeries to be set")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestBollingerBandSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	s1 := mockValuesProvider{
+		X: LinearRange(1.0, 100.0),
+		Y: RandomValuesWithMax(100, 1024),
+	}
+
+	bbs := &BollingerBandsSeries{
+		InnerSeries: s1,
+	}
+
+	xvalues := make([]float64, 100)
+	y1values := make([]float64, 100)
+	y2values := make([]float64, 100)
+
+	for x := 0; x < 100; x++ {
+		xvalues[x], y1values[x], y2values[x] = bbs.GetBoundedValues(x)
+	}
+
+	for x := bbs.GetPeriod(); x < 100; x++ {
+		testutil.AssertTrue(t, y1values[x] > y2values[x], fmt.Sprintf("%v vs. %v", y1values[x], y2values[x]))
+	}
+}
+
+func TestBollingerBandSeriesWindow(t *testing.T) {
+	// replaced new assertions helper
+
+	s1 := mockValuesProvider{
+		X: LinearRange(1.0, 10.0),
+		Y: []float64{2, 4, 4, 4, 5, 5, 7, 9, 2, 4},
+	}
+
+	bbs := &BollingerBandsSeries{
+		InnerSeries: s1,
+		Period:      8,
+		K:           1.0,
+	}
+
+	// the window ending at index 7 is the classic mean 5, stddev 2 example.
+	x, y1, y2 := bbs.GetBoundedValues(7)
+	testutil.AssertEqual(t, 8.0, x)
+	testutil.AssertInDelta(t, 7.0, y1, 0.0001)
+	testutil.AssertInDelta(t, 3.0, y2, 0.0001)
+
+	_, ly := bbs.GetLastValues()
+	lx, ly1, ly2 := bbs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 10.0, lx)
+	testutil.AssertInDelta(t, ly, (ly1+ly2)/2.0, 0.0001)
+}
+
+func TestBollingerBandLastValue(t *testing.T) {
+	// replaced new assertions helper
+
+	s1 := mockValuesProvider{
+		X: LinearRange(1.0, 100.0),
+		Y: LinearRange(1.0, 100.0),
+	}
+
+	bbs := &BollingerBandsSeries{
+		InnerSeries: s1,
+	}
+
+	x, y1, y2 := bbs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 100.0, x)
+	testutil.AssertEqual(t, 101, math.Floor(y1))
+	testutil.AssertEqual(t, 83, math.Floor(y2))
+}
+
+func TestMovingAverageSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: LinearRange(1.0, 100.0),
+		YValues: RandomValuesWithMax(100, 1024),
+	}
+
+	graph := Chart{
+		Series: []Series{
+			inner,
+			&BollingerBandsSeries{InnerSeries: inner},
+			SMASeries{InnerSeries: inner},
+			&EMASeries{InnerSeries: inner},
+		},
+	}
+
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, graph.Render(rp, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
@@ -163,6 +163,13 @@ func (b Box) Shift(x, y int) Box {
 	}
 }
 
+// Intersects returns if the box overlaps another box; boxes that only share
+// an edge do not overlap.
+func (b Box) Intersects(other Box) bool {
+	return b.Left < other.Right && other.Left < b.Right &&
+		b.Top < other.Bottom && other.Top < b.Bottom
+}
+
 // Corners returns the box as a set of corners.
 func (b Box) Corners() BoxCorners {
 	return BoxCorners{
@@ -0,0 +1,195 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*BoxPlotSeries)(nil)
+	_ CategoriesProvider  = (*BoxPlotSeries)(nil)
+	_ ValueBoundsProvider = (*BoxPlotSeries)(nil)
+)
+
+// BoxPlotWhiskers is an enum for how far the whiskers of a box plot reach.
+type BoxPlotWhiskers int
+
+const (
+	// BoxPlotWhiskersUnset is the unset state for whiskers, which reach 1.5 IQR.
+	BoxPlotWhiskersUnset BoxPlotWhiskers = 0
+	// BoxPlotWhiskersIQR reaches the furthest samples within 1.5 interquartile
+	// ranges of the box; the samples beyond are drawn as outliers.
+	BoxPlotWhiskersIQR BoxPlotWhiskers = 1
+	// BoxPlotWhiskersMinMax reaches the smallest and largest samples.
+	BoxPlotWhiskersMinMax BoxPlotWhiskers = 2
+)
+
+// BoxPlotStats is the summary of a group drawn by a box plot.
+type BoxPlotStats struct {
+	// Count is the number of samples, NaN and infinite values excluded.
+	Count int
+
+	Q1, Median, Q3 float64
+
+	LowerWhisker, UpperWhisker float64
+	Outliers                   []float64
+}
+
+// BoxPlotSeries draws the distribution of each of its groups as a box from
+// the first to the third quartile, a line at the median, and whiskers with
+// any outliers beyond them.
+//
+// The series works with a CategoryRange x axis, which takes its categories
+// from the group labels, or a numeric x axis.
+type BoxPlotSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Groups   []DistributionGroup
+	Whiskers BoxPlotWhiskers
+
+	// BoxWidth is the fraction of the space for each group that its box fills.
+	BoxWidth float64
+}
+
+// GetName returns the name of the series.
+func (bps BoxPlotSeries) GetName() string {
+	return bps.Name
+}
+
+// GetStyle returns the series style.
+func (bps BoxPlotSeries) GetStyle() Style {
+	return bps.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bps BoxPlotSeries) GetYAxis() YAxisType {
+	return bps.YAxis
+}
+
+// GetCategories returns the labels of the groups in order.
+func (bps BoxPlotSeries) GetCategories() []string {
+	return distributionGroups(bps.Groups).categories()
+}
+
+// GetBoxWidth returns the fraction of the space for each group its box fills.
+func (bps BoxPlotSeries) GetBoxWidth() float64 {
+	if bps.BoxWidth <= 0 {
+		return DefaultCategoryBandFill
+	}
+	return bps.BoxWidth
+}
+
+// Len returns the number of groups.
+func (bps BoxPlotSeries) Len() int {
+	return len(bps.Groups)
+}
+
+// GetValueBounds returns the bounds of the groups and their samples.
+func (bps BoxPlotSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	return distributionGroups(bps.Groups).valueBounds()
+}
+
+// GetStats returns the summary of the group at a given index.
+func (bps BoxPlotSeries) GetStats(index int) (stats BoxPlotStats) {
+	samples := distributionGroups(bps.Groups).samples(index)
+	stats.Count = samples.Len()
+	if stats.Count == 0 {
+		return
+	}
+
+	stats.Q1 = samples.Percentile(0.25)
+	stats.Median = samples.Median()
+	stats.Q3 = samples.Percentile(0.75)
+
+	stats.LowerWhisker, stats.UpperWhisker = samples.MinMax()
+	if bps.Whiskers == BoxPlotWhiskersMinMax {
+		return
+	}
+
+	reach := DefaultBoxPlotWhiskerIQR * (stats.Q3 - stats.Q1)
+	lowerFence, upperFence := stats.Q1-reach, stats.Q3+reach
+	stats.LowerWhisker, stats.UpperWhisker = stats.Q1, stats.Q3
+	for _, v := range samples.Values() {
+		if v < lowerFence || v > upperFence {
+			stats.Outliers = append(stats.Outliers, v)
+			continue
+		}
+		stats.LowerWhisker = math.Min(stats.LowerWhisker, v)
+		stats.UpperWhisker = math.Max(stats.UpperWhisker, v)
+	}
+	return
+}
+
+// Render renders the series.
+func (bps BoxPlotSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bps.Style.InheritFrom(bps.boxPlotStyleDefaults(defaults))
+	groups := distributionGroups(bps.Groups)
+	halfWidth := int(groups.width(xrange, bps.GetBoxWidth()) / 2)
+	capWidth := halfWidth >> 1
+
+	y := func(v float64) int {
+		return canvasBox.Bottom - yrange.Translate(v)
+	}
+
+	for index := range groups {
+		stats := bps.GetStats(index)
+		if stats.Count == 0 {
+			continue
+		}
+		x := canvasBox.Left + xrange.Translate(groups.center(xrange, index))
+
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x, y(stats.Q3))
+		r.LineTo(x, y(stats.UpperWhisker))
+		r.MoveTo(x-capWidth, y(stats.UpperWhisker))
+		r.LineTo(x+capWidth, y(stats.UpperWhisker))
+		r.MoveTo(x, y(stats.Q1))
+		r.LineTo(x, y(stats.LowerWhisker))
+		r.MoveTo(x-capWidth, y(stats.LowerWhisker))
+		r.LineTo(x+capWidth, y(stats.LowerWhisker))
+		r.Stroke()
+		r.ResetStyle()
+
+		Draw.Box(r, Box{
+			Top:    y(stats.Q3),
+			Left:   x - halfWidth,
+			Right:  x + halfWidth,
+			Bottom: y(stats.Q1),
+		}, style)
+
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x-halfWidth, y(stats.Median))
+		r.LineTo(x+halfWidth, y(stats.Median))
+		r.Stroke()
+		r.ResetStyle()
+
+		if len(stats.Outliers) > 0 {
+			style.GetDotOptions().WriteToRenderer(r)
+			for _, v := range stats.Outliers {
+				Draw.Marker(r, MarkerShapeCircle, x, y(v), style.GetDotWidth())
+			}
+			r.ResetStyle()
+		}
+	}
+}
+
+func (bps BoxPlotSeries) boxPlotStyleDefaults(defaults Style) Style {
+	return Style{
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: defaults.StrokeWidth,
+		FillColor:   defaults.StrokeColor.WithAlpha(DefaultDistributionFillAlpha),
+		DotColor:    defaults.StrokeColor,
+		DotWidth:    DefaultBoxPlotOutlierSize,
+	}
+}
+
+// Validate validates the series.
+func (bps BoxPlotSeries) Validate() error {
+	if !distributionGroups(bps.Groups).hasSamples() {
+		return fmt.Errorf("box plot series requires groups with values")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testDistributionGroups() []DistributionGroup {
+	return []DistributionGroup{
+		{Label: "a", X: 1, Values: []float64{1, 2, 2, 3, 3, 3, 4, 4, 5, 12, math.NaN()}},
+		{Label: "b", X: 3, Values: []float64{4, 5, 5, 6, 6, 7, 6.5, 5.5, 8}},
+		{Label: "c", X: 4},
+	}
+}
+
+func TestBoxPlotSeriesGetStats(t *testing.T) {
+	// replaced new assertions helper
+
+	bps := BoxPlotSeries{Groups: testDistributionGroups()}
+
+	stats := bps.GetStats(0)
+	testutil.AssertEqual(t, 10, stats.Count)
+	testutil.AssertEqual(t, 2.25, stats.Q1)
+	testutil.AssertEqual(t, 3.0, stats.Median)
+	testutil.AssertEqual(t, 4.0, stats.Q3)
+	testutil.AssertEqual(t, 1.0, stats.LowerWhisker)
+	testutil.AssertEqual(t, 5.0, stats.UpperWhisker)
+	testutil.AssertEqual(t, []float64{12}, stats.Outliers)
+
+	bps.Whiskers = BoxPlotWhiskersMinMax
+	stats = bps.GetStats(0)
+	testutil.AssertEqual(t, 12.0, stats.UpperWhisker)
+	testutil.AssertEmpty(t, stats.Outliers)
+
+	testutil.AssertZero(t, bps.GetStats(2).Count)
+}
+
+func TestBoxPlotSeriesBounds(t *testing.T) {
+	// replaced new assertions helper
+
+	bps := BoxPlotSeries{Groups: testDistributionGroups()}
+	testutil.AssertEqual(t, []string{"a", "b", "c"}, bps.GetCategories())
+	testutil.AssertEqual(t, 3, bps.Len())
+
+	// the groups are padded by half the smallest spacing between them.
+	minx, maxx, miny, maxy := bps.GetValueBounds()
+	testutil.AssertEqual(t, 0.5, minx)
+	testutil.AssertEqual(t, 4.5, maxx)
+	testutil.AssertEqual(t, 1.0, miny)
+	testutil.AssertEqual(t, 12.0, maxy)
+}
+
+func TestBoxPlotSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	xrange := &CategoryRange{Categories: []string{"b", "a"}, Domain: 200}
+	yrange := &ContinuousRange{Min: 0, Max: 20, Domain: 200}
+	bps := BoxPlotSeries{Groups: testDistributionGroups()[:1]}
+
+	r, err := SVG(200, 200)
+	testutil.AssertNil(t, err)
+	bps.Render(r, Box{Right: 200, Bottom: 200}, xrange, yrange, Style{StrokeColor: ColorBlue, StrokeWidth: 1})
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	svg := buffer.String()
+
+	// the group is drawn in the band of its label, 80 pixels wide.
+	testutil.AssertContains(t, svg, `d="M 110 160L 190 160L 190 177L 110 177L 110 160"`)
+	testutil.AssertContains(t, svg, `d="M 110 170L 190 170"`)
+	testutil.AssertContains(t, svg, `d="M 150 160L 150 150M 130 150L 170 150M 150 177L 150 190M 130 190L 170 190"`)
+	testutil.AssertContains(t, svg, `<circle cx="150" cy="80" r="3"`)
+}
+
+func TestBoxPlotSeriesChart(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, xrange := range []Range{&CategoryRange{}, nil} {
+		c := Chart{
+			XAxis:  XAxis{Range: xrange},
+			Series: []Series{BoxPlotSeries{Groups: testDistributionGroups()}},
+		}
+
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, c.Render(SVG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+
+		buffer.Reset()
+		testutil.AssertNil(t, c.Render(PNG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
+
+func TestBoxPlotSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, BoxPlotSeries{}.Validate())
+	testutil.AssertNotNil(t, BoxPlotSeries{Groups: []DistributionGroup{{Values: []float64{math.NaN()}}}}.Validate())
+	testutil.AssertNil(t, BoxPlotSeries{Groups: testDistributionGroups()}.Validate())
+}
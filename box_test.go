@@ -144,6 +144,16 @@ func TestBoxShift(t *testing.T) {
 	testutil.AssertEqual(t, 12, shifted.Bottom)
 }
 
+func TestBoxIntersects(t *testing.T) {
+	// replaced new assertions helper
+
+	b := Box{Top: 5, Left: 5, Right: 10, Bottom: 10}
+	testutil.AssertTrue(t, b.Intersects(Box{Top: 9, Left: 9, Right: 20, Bottom: 20}))
+	testutil.AssertTrue(t, b.Intersects(Box{Top: 0, Left: 0, Right: 20, Bottom: 20}))
+	testutil.AssertFalse(t, b.Intersects(Box{Top: 10, Left: 5, Right: 10, Bottom: 15}))
+	testutil.AssertFalse(t, b.Intersects(Box{Top: 5, Left: 11, Right: 20, Bottom: 10}))
+}
+
 func TestBoxCenter(t *testing.T) {
 	// replaced new assertions helper
 
@@ -0,0 +1,137 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*CategoryRange)(nil)
+	_ TicksProvider = (*CategoryRange)(nil)
+	_ RangeF        = (*CategoryRange)(nil)
+)
+
+// CategoriesProvider is a type that provides a set of categories.
+type CategoriesProvider interface {
+	GetCategories() []string
+}
+
+// CategoryRange is a band scale that maps a set of categories to evenly spaced
+// bands across the domain.
+//
+// A category is addressed by its index in Categories; the value for the
+// category at index `i` translates to the center of the `i`th band.
+// If no categories are set, the chart will use the categories of the first
+// series that provides them.
+type CategoryRange struct {
+	Categories []string
+	Domain     int
+	Descending bool
+}
+
+// IsDescending returns if the range is descending.
+func (r CategoryRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the CategoryRange has been set or not.
+func (r CategoryRange) IsZero() bool {
+	return len(r.Categories) == 0
+}
+
+// GetMin gets the min value for the category range, which is the left edge of the first band.
+func (r CategoryRange) GetMin() float64 {
+	return -0.5
+}
+
+// SetMin is a no-op as the bounds are derived from the categories.
+func (r *CategoryRange) SetMin(_ float64) {}
+
+// GetMax returns the max value for the category range, which is the right edge of the last band.
+func (r CategoryRange) GetMax() float64 {
+	return float64(len(r.Categories)) - 0.5
+}
+
+// SetMax is a no-op as the bounds are derived from the categories.
+func (r *CategoryRange) SetMax(_ float64) {}
+
+// GetDelta returns the difference between the min and max value.
+func (r CategoryRange) GetDelta() float64 {
+	return float64(len(r.Categories))
+}
+
+// GetDomain returns the range domain.
+func (r CategoryRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *CategoryRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// GetBandWidth returns the width of each category band in the domain.
+func (r CategoryRange) GetBandWidth() int {
+	if len(r.Categories) == 0 {
+		return 0
+	}
+	return r.Domain / len(r.Categories)
+}
+
+// IndexOf returns the index of a given category, or -1 if it is not in the range.
+func (r CategoryRange) IndexOf(category string) int {
+	for index, c := range r.Categories {
+		if c == category {
+			return index
+		}
+	}
+	return -1
+}
+
+// String returns a simple string for the CategoryRange.
+func (r CategoryRange) String() string {
+	if len(r.Categories) == 0 {
+		return "CategoryRange [empty]"
+	}
+	return fmt.Sprintf("CategoryRange [%d categories] => %d", len(r.Categories), r.Domain)
+}
+
+// Translate maps a given category index into the CategoryRange space.
+func (r CategoryRange) Translate(value float64) int {
+	if len(r.Categories) == 0 {
+		return 0
+	}
+	ratio := (value - r.GetMin()) / r.GetDelta()
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateF maps a given value into the CategoryRange space without rounding.
+func (r CategoryRange) TranslateF(value float64) float64 {
+	if len(r.Categories) == 0 {
+		return 0
+	}
+	ratio := (value - r.GetMin()) / r.GetDelta()
+
+	if r.IsDescending() {
+		return float64(r.Domain) - ratio*float64(r.Domain)
+	}
+	return ratio * float64(r.Domain)
+}
+
+// GetTicks returns a tick labeled with the category name at the center of each band.
+func (r CategoryRange) GetTicks(_ Renderer, _ Style, _ ValueFormatter) []Tick {
+	ticks := make([]Tick, 0, len(r.Categories))
+	for index, category := range r.Categories {
+		ticks = append(ticks, Tick{
+			Value: float64(index),
+			Label: category,
+		})
+	}
+	return ticks
+}
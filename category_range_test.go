@@ -0,0 +1,104 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestCategoryRangeTranslate(t *testing.T) {
+	// replaced new assertions helper
+
+	r := CategoryRange{Categories: []string{"a", "b", "c", "d"}, Domain: 400}
+	testutil.AssertEqual(t, -0.5, r.GetMin())
+	testutil.AssertEqual(t, 3.5, r.GetMax())
+	testutil.AssertEqual(t, 100, r.GetBandWidth())
+
+	testutil.AssertEqual(t, 50, r.Translate(0))
+	testutil.AssertEqual(t, 150, r.Translate(1))
+	testutil.AssertEqual(t, 350, r.Translate(3))
+
+	r.Descending = true
+	testutil.AssertEqual(t, 350, r.Translate(0))
+	testutil.AssertEqual(t, 50, r.Translate(3))
+}
+
+func TestCategoryRangeSetBounds(t *testing.T) {
+	// replaced new assertions helper
+
+	r := &CategoryRange{Categories: []string{"a", "b"}}
+	r.SetMin(10)
+	r.SetMax(20)
+	testutil.AssertEqual(t, -0.5, r.GetMin())
+	testutil.AssertEqual(t, 1.5, r.GetMax())
+
+	testutil.AssertTrue(t, (&CategoryRange{}).IsZero())
+	testutil.AssertFalse(t, r.IsZero())
+}
+
+func TestCategoryRangeGetTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	r := CategoryRange{Categories: []string{"a", "b", "c"}}
+	ticks := r.GetTicks(nil, Style{}, nil)
+	testutil.AssertLen(t, ticks, 3)
+	testutil.AssertEqual(t, 1.0, ticks[1].Value)
+	testutil.AssertEqual(t, "b", ticks[1].Label)
+
+	testutil.AssertEqual(t, 2, r.IndexOf("c"))
+	testutil.AssertEqual(t, -1, r.IndexOf("z"))
+}
+
+func TestChartCategoryRange(t *testing.T) {
+	// replaced new assertions helper
+
+	xrange := &CategoryRange{}
+	c := Chart{
+		XAxis: XAxis{
+			Range: xrange,
+		},
+		Series: []Series{
+			CategorySeries{
+				XValues: []string{"dark", "light", "sepia"},
+				YValues: []float64{30, 20, 5},
+				Bars:    true,
+			},
+			CategorySeries{
+				XValues: []string{"dark", "light", "sepia"},
+				YValues: []float64{10, 25, 15},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+	// the categories of the first series are used, without being kept.
+	testutil.AssertTrue(t, xrange.IsZero())
+	rendered, _, _ := c.getRanges()
+	testutil.AssertEqual(t, []string{"dark", "light", "sepia"}, rendered.(*CategoryRange).Categories)
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "sepia")
+}
+
+func TestChartCategoryRangeEmpty(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		XAxis: XAxis{
+			Range: &CategoryRange{},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, c.Render(PNG, buffer))
+}
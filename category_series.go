@@ -0,0 +1,162 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series                 = (*CategorySeries)(nil)
+	_ FirstValuesProvider    = (*CategorySeries)(nil)
+	_ LastValuesProvider     = (*CategorySeries)(nil)
+	_ CategoriesProvider     = (*CategorySeries)(nil)
+	_ ValueFormatterProvider = (*CategorySeries)(nil)
+)
+
+// CategorySeries represents a series of values keyed by category names.
+// It is meant to be plotted against a CategoryRange x-axis. The x value of
+// each point is the index of its category in the series, and when rendered
+// against a CategoryRange, the index of its category in the range.
+type CategorySeries struct {
+	Name  string
+	Style Style
+
+	YAxis YAxisType
+
+	YValueFormatter ValueFormatter
+
+	// Bars draws the series as boxes filling each category band rather than as a line.
+	Bars bool
+
+	XValues []string
+	YValues []float64
+}
+
+// GetName returns the name of the series.
+func (cs CategorySeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the line style.
+func (cs CategorySeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs CategorySeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// GetCategories returns the categories of the series in order.
+func (cs CategorySeries) GetCategories() []string {
+	return cs.XValues
+}
+
+// Len returns the number of elements in the series.
+func (cs CategorySeries) Len() int {
+	return len(cs.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (cs CategorySeries) GetValues(index int) (x, y float64) {
+	return float64(index), cs.YValues[index]
+}
+
+// GetFirstValues gets the first x,y values.
+func (cs CategorySeries) GetFirstValues() (x, y float64) {
+	return cs.GetValues(0)
+}
+
+// GetLastValues gets the last x,y values.
+func (cs CategorySeries) GetLastValues() (x, y float64) {
+	return cs.GetValues(len(cs.XValues) - 1)
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+// The x formatter maps a category index back to its name.
+func (cs CategorySeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = func(v interface{}) string {
+		if typed, isTyped := v.(float64); isTyped {
+			index := int(typed)
+			if index >= 0 && index < len(cs.XValues) {
+				return cs.XValues[index]
+			}
+		}
+		return ""
+	}
+	if cs.YValueFormatter != nil {
+		y = cs.YValueFormatter
+	} else {
+		y = FloatValueFormatter
+	}
+	return
+}
+
+// Render renders the series.
+func (cs CategorySeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := cs.Style.InheritFrom(defaults)
+	if !cs.Bars {
+		if cr, isCategoryRange := xrange.(*CategoryRange); isCategoryRange {
+			Draw.LineSeries(r, canvasBox, xrange, yrange, style, categoryRangeValues{cs, cr})
+			return
+		}
+		Draw.LineSeries(r, canvasBox, xrange, yrange, style, cs)
+		return
+	}
+
+	if style.FillColor.IsZero() {
+		style.FillColor = style.StrokeColor
+	}
+	if cr, isCategoryRange := xrange.(*CategoryRange); isCategoryRange {
+		barWidth := int(float64(cr.GetBandWidth()) * DefaultCategoryBandFill)
+		Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, categoryRangeValues{cs, cr}, barWidth)
+		return
+	}
+	Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, cs)
+}
+
+// Validate validates the series.
+func (cs CategorySeries) Validate() error {
+	if len(cs.XValues) == 0 {
+		return fmt.Errorf("category series; must have xvalues set")
+	}
+
+	if len(cs.YValues) == 0 {
+		return fmt.Errorf("category series; must have yvalues set")
+	}
+
+	if len(cs.XValues) != len(cs.YValues) {
+		return fmt.Errorf("category series; must have same length xvalues as yvalues")
+	}
+	return nil
+}
+
+// categoryRangeValues resolves the categories of a series through the range
+// it is drawn against, falling back to the index in the series for a
+// category the range does not have.
+type categoryRangeValues struct {
+	CategorySeries
+	cr *CategoryRange
+}
+
+// GetValues gets the range index and y value at a given index.
+func (crv categoryRangeValues) GetValues(index int) (x, y float64) {
+	if categoryIndex := crv.cr.IndexOf(crv.XValues[index]); categoryIndex >= 0 {
+		return float64(categoryIndex), crv.YValues[index]
+	}
+	return crv.CategorySeries.GetValues(index)
+}
+
+// GetValueFormatters returns value formatters where the x formatter maps a
+// range index back to its category.
+func (crv categoryRangeValues) GetValueFormatters() (x, y ValueFormatter) {
+	sx, y := crv.CategorySeries.GetValueFormatters()
+	x = func(v interface{}) string {
+		if typed, isTyped := v.(float64); isTyped {
+			index := int(typed)
+			if index >= 0 && index < len(crv.cr.Categories) {
+				return crv.cr.Categories[index]
+			}
+		}
+		return sx(v)
+	}
+	return
+}
@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestCategorySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := CategorySeries{
+		XValues: []string{"a", "b", "c"},
+		YValues: []float64{1, 2, 3},
+	}
+	testutil.AssertNil(t, cs.Validate())
+	testutil.AssertEqual(t, 3, cs.Len())
+
+	x, y := cs.GetValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 2.0, y)
+
+	x, y = cs.GetLastValues()
+	testutil.AssertEqual(t, 2.0, x)
+	testutil.AssertEqual(t, 3.0, y)
+
+	xf, _ := cs.GetValueFormatters()
+	testutil.AssertEqual(t, "c", xf(2.0))
+	testutil.AssertEqual(t, "", xf(5.0))
+}
+
+func TestCategorySeriesCategoryRange(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := CategorySeries{
+		XValues: []string{"a", "b", "z"},
+		YValues: []float64{1, 2, 3},
+	}
+	crv := categoryRangeValues{cs, &CategoryRange{Categories: []string{"c", "b", "a"}}}
+
+	// the categories are resolved through the range, in its order.
+	x, y := crv.GetValues(0)
+	testutil.AssertEqual(t, 2.0, x)
+	testutil.AssertEqual(t, 1.0, y)
+	x, _ = crv.GetValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	// a category the range lacks keeps its index in the series.
+	x, _ = crv.GetValues(2)
+	testutil.AssertEqual(t, 2.0, x)
+
+	xf, _ := crv.GetValueFormatters()
+	testutil.AssertEqual(t, "c", xf(0.0))
+	testutil.AssertEqual(t, "a", xf(2.0))
+}
+
+func TestCategorySeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, CategorySeries{}.Validate())
+	testutil.AssertNotNil(t, CategorySeries{XValues: []string{"a"}}.Validate())
+	testutil.AssertNotNil(t, CategorySeries{
+		XValues: []string{"a"},
+		YValues: []float64{1, 2},
+	}.Validate())
+}
@@ -1,10 +1,13 @@
 package chart
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"reflect"
+	"strconv"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -14,6 +17,18 @@ type Chart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is the accessible description of the chart, for renderers
+	// that support it (SVG). It defaults to a summary of the series.
+	Description string
+	// DataTable adds a visually hidden table of the series values for screen
+	// readers, for renderers that support it (SVG).
+	DataTable bool
+
+	// ID prefixes the ids of the chart's element groups, for renderers that
+	// support them (SVG), so several charts can share a document.
+	// It defaults to DefaultGroupIDPrefix.
+	ID string
+
 	ColorPalette ColorPalette
 
 	Width  int
@@ -33,6 +48,9 @@ type Chart struct {
 	Series   []Series
 	Elements []Renderable
 
+	// Limits are resource limits enforced by Render and RenderContext.
+	Limits RenderLimits
+
 	Log Logger
 }
 
@@ -73,12 +91,22 @@ func (c Chart) GetHeight() int {
 
 // Render renders the chart with the given renderer to the given io.Writer.
 func (c Chart) Render(rp RendererProvider, w io.Writer) error {
+	return c.RenderContext(context.Background(), rp, w)
+}
+
+// RenderContext renders the chart with the given renderer to the given io.Writer.
+// It stops rendering and returns the context error if the context is done, and
+// returns a *LimitError if rendering would exceed the chart's Limits.
+func (c Chart) RenderContext(ctx context.Context, rp RendererProvider, w io.Writer) error {
 	if len(c.Series) == 0 {
 		return errors.New("please provide at least one series")
 	}
 	if err := c.checkHasVisibleSeries(); err != nil {
 		return err
 	}
+	if err := c.checkLimits(); err != nil {
+		return err
+	}
 
 	c.YAxisSecondary.AxisType = YAxisSecondary
 
@@ -86,6 +114,8 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	if err != nil {
 		return err
 	}
+	r, lr := c.Limits.wrap(r)
+	setAccessibleText(r, c.Title, c.getDescription())
 
 	if c.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -96,7 +126,9 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	}
 	r.SetDPI(c.GetDPI(DefaultDPI))
 
+	beginGroup(r, c.getGroup("background", "background", c.Background.ClassName))
 	c.drawBackground(r)
+	endGroup(r)
 
 	var xt, yt, yta []Tick
 	xr, yr, yra := c.getRanges()
@@ -112,6 +144,9 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		_ = r.Save(w)
 		return err
 	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
 
 	if c.hasAxes() {
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
@@ -119,28 +154,97 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		xr, yr, yra = setRangeDomains(canvasBox, xr, yr, yra)
 
 		Debugf(c.Log, "chart; axes adjusted canvas box: %v", canvasBox)
+		if err = ctx.Err(); err != nil {
+			return err
+		}
 
 		// do a second pass in case things haven't settled yet.
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
 		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
 		xr, yr, yra = setRangeDomains(canvasBox, xr, yr, yra)
+
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	if c.hasAnnotationSeries() {
+		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra)
+		xr, yr, yra = setRangeDomains(canvasBox, xr, yr, yra)
+
+		Debugf(c.Log, "chart; annotation adjusted canvas box: %v", canvasBox)
+
+		if c.hasAxes() {
+			xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
 	}
 
+	beginGroup(r, c.getGroup("canvas", "canvas", c.Canvas.ClassName))
 	c.drawCanvas(r, canvasBox)
-	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
-	for index, series := range c.Series {
-		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+	endGroup(r)
+	if err = c.drawAxes(ctx, r, canvasBox, xr, yr, yra, xt, yt, yta); err != nil {
+		return err
+	}
+	if err = lr.Err(); err != nil {
+		return err
+	}
+	seriesIDs := c.getSeriesIDs()
+	for _, index := range c.getSeriesDrawOrder() {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		c.drawSeries(r, canvasBox, xr, yr, yra, c.Series[index], index, seriesIDs[index])
+		if err = lr.Err(); err != nil {
+			return err
+		}
 	}
 
 	c.drawTitle(r)
 
-	for _, a := range c.Elements {
+	for index, a := range c.Elements {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		beginGroup(r, c.getGroup("element-"+strconv.Itoa(index+1), "element"))
 		a(r, canvasBox, c.styleDefaultsElements())
+		endGroup(r)
+	}
+	if err = lr.Err(); err != nil {
+		return err
 	}
 
+	if c.DataTable {
+		c.addDataTables(r, xf, yf, yfa)
+	}
 	return r.Save(w)
 }
 
+// checkLimits checks the chart size and series lengths against the limits
+// before anything is allocated.
+func (c Chart) checkLimits() error {
+	if c.Limits.IsZero() {
+		return nil
+	}
+	if err := c.Limits.CheckCanvas(c.GetWidth(), c.GetHeight()); err != nil {
+		return err
+	}
+	for _, s := range c.Series {
+		if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+			if err := c.Limits.CheckSeriesPoints(s.GetName(), vp.Len()); err != nil {
+				return err
+			}
+		} else if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
+			if err := c.Limits.CheckSeriesPoints(s.GetName(), bvp.Len()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (c Chart) checkHasVisibleSeries() error {
 	var style Style
 	for _, s := range c.Series {
@@ -175,7 +279,23 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 	for _, s := range c.Series {
 		if !s.GetStyle().Hidden {
 			seriesAxis := s.GetYAxis()
-			if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
+			if vbp, isValueBoundsProvider := s.(ValueBoundsProvider); isValueBoundsProvider {
+				if vbp.Len() > 0 {
+					vx1, vx2, vy1, vy2 := vbp.GetValueBounds()
+
+					minx = math.Min(minx, vx1)
+					maxx = math.Max(maxx, vx2)
+
+					if seriesAxis == YAxisPrimary {
+						miny = math.Min(miny, vy1)
+						maxy = math.Max(maxy, vy2)
+					} else if seriesAxis == YAxisSecondary {
+						minya = math.Min(minya, vy1)
+						maxya = math.Max(maxya, vy2)
+						seriesMappedToSecondaryAxis = true
+					}
+				}
+			} else if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
 				seriesLength := bvp.Len()
 				for index := 0; index < seriesLength; index++ {
 					vx, vy1, vy2 := bvp.GetBoundedValues(index)
@@ -223,6 +343,18 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		xrange = c.XAxis.Range
 	}
 
+	if cr, isCategoryRange := xrange.(*CategoryRange); isCategoryRange && cr.IsZero() {
+		// fill a copy, so the fallback categories don't stick to the chart.
+		for _, s := range c.Series {
+			if cp, isCategoriesProvider := s.(CategoriesProvider); isCategoriesProvider {
+				filled := *cr
+				filled.Categories = cp.GetCategories()
+				xrange = &filled
+				break
+			}
+		}
+	}
+
 	if c.YAxis.Range == nil {
 		yrange = &ContinuousRange{}
 	} else {
@@ -261,12 +393,7 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		yrange.SetMax(maxy)
 
 		if !c.YAxis.Style.Hidden {
-			delta := yrange.GetDelta()
-			roundTo := GetRoundToForDelta(delta)
-			rmin, rmax := RoundDown(yrange.GetMin(), roundTo), RoundUp(yrange.GetMax(), roundTo)
-
-			yrange.SetMin(rmin)
-			yrange.SetMax(rmax)
+			c.roundRange(yrange)
 		}
 	}
 
@@ -283,17 +410,28 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		yrangeAlt.SetMax(maxya)
 
 		if !c.YAxisSecondary.Style.Hidden {
-			delta := yrangeAlt.GetDelta()
-			roundTo := GetRoundToForDelta(delta)
-			rmin, rmax := RoundDown(yrangeAlt.GetMin(), roundTo), RoundUp(yrangeAlt.GetMax(), roundTo)
-			yrangeAlt.SetMin(rmin)
-			yrangeAlt.SetMax(rmax)
+			c.roundRange(yrangeAlt)
 		}
 	}
 
 	return
 }
 
+// roundRange expands the range bounds to values that produce clean ticks.
+func (c Chart) roundRange(ra Range) {
+	if lr, isLogarithmic := ra.(*LogarithmicRange); isLogarithmic {
+		lr.RoundToPowers()
+		return
+	}
+
+	delta := ra.GetDelta()
+	roundTo := GetRoundToForDelta(delta)
+	rmin, rmax := RoundDown(ra.GetMin(), roundTo), RoundUp(ra.GetMax(), roundTo)
+
+	ra.SetMin(rmin)
+	ra.SetMax(rmax)
+}
+
 func (c Chart) checkRanges(xr, yr, yra Range) error {
 	Debugf(c.Log, "checking xrange: %v", xr)
 	xDelta := xr.GetDelta()
@@ -306,6 +444,9 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	if xDelta == 0 {
 		return errors.New("zero x-range delta; there needs to be at least (2) values")
 	}
+	if isNonPositiveLogarithmicRange(xr) {
+		return errors.New("non-positive x-range value; logarithmic ranges require positive values")
+	}
 
 	Debugf(c.Log, "checking yrange: %v", yr)
 	yDelta := yr.GetDelta()
@@ -315,6 +456,9 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	if math.IsNaN(yDelta) {
 		return errors.New("nan y-range delta")
 	}
+	if isNonPositiveLogarithmicRange(yr) {
+		return errors.New("non-positive y-range value; logarithmic ranges require positive values")
+	}
 
 	if c.hasSecondarySeries() {
 		Debugf(c.Log, "checking secondary yrange: %v", yra)
@@ -325,6 +469,9 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 		if math.IsNaN(yraDelta) {
 			return errors.New("nan secondary y-range delta")
 		}
+		if isNonPositiveLogarithmicRange(yra) {
+			return errors.New("non-positive secondary y-range value; logarithmic ranges require positive values")
+		}
 	}
 
 	return nil
@@ -397,6 +544,33 @@ func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra R
 	return canvasBox.OuterConstrain(c.Box(), axesOuterBox)
 }
 
+func (c Chart) hasAnnotationSeries() bool {
+	for _, s := range c.Series {
+		if ms, isMeasured := s.(MeasuredSeries); isMeasured && !ms.GetStyle().Hidden {
+			return true
+		}
+	}
+	return false
+}
+
+// getAnnotationAdjustedCanvasBox shrinks the canvas so the callouts of the
+// annotation series fit within the chart.
+func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range) Box {
+	annotationSeriesBox := canvasBox.Clone()
+	for seriesIndex, s := range c.Series {
+		if ms, isMeasured := s.(MeasuredSeries); isMeasured {
+			yrange := yr
+			if ms.GetYAxis() == YAxisSecondary {
+				yrange = yra
+			}
+			annotationBounds := ms.Measure(r, canvasBox, xr, yrange, c.getSeriesDefaults(s, seriesIndex))
+			Debugf(c.Log, "chart; annotation series measured %v", annotationBounds)
+			annotationSeriesBox = annotationSeriesBox.Grow(annotationBounds)
+		}
+	}
+	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+}
+
 func setRangeDomains(canvasBox Box, xr, yr, yra Range) (x, y, yAlt Range) {
 	xr.SetDomain(canvasBox.Width())
 	yr.SetDomain(canvasBox.Height())
@@ -432,30 +606,192 @@ func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, c.getCanvasStyle())
 }
 
-func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
+// drawAxes draws the axes, stopping between them if the context is done.
+func (c Chart) drawAxes(ctx context.Context, r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) error {
 	if !c.XAxis.Style.Hidden && xrange.GetMin() != xrange.GetMax() {
+		beginGroup(r, c.getGroup("x-axis", "axis x-axis", c.XAxis.Style.ClassName))
 		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
+		endGroup(r)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 	if !c.YAxis.Style.Hidden && yrange.GetMin() != yrange.GetMax() {
+		beginGroup(r, c.getGroup("y-axis", "axis y-axis", c.YAxis.Style.ClassName))
 		c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
+		endGroup(r)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 	if !c.YAxisSecondary.Style.Hidden && yrangeAlt.GetMin() != yrangeAlt.GetMax() {
+		beginGroup(r, c.getGroup("y-axis-secondary", "axis y-axis-secondary", c.YAxisSecondary.Style.ClassName))
 		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
+		endGroup(r)
 	}
+	return nil
 }
 
-func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int) {
+func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int, seriesID string) {
 	if !s.GetStyle().Hidden {
+		group := c.getGroup(seriesID, "series", s.GetStyle().ClassName)
+		group.Label = c.getSeriesLabel(s, seriesIndex)
+		beginGroup(r, group)
 		if s.GetYAxis() == YAxisPrimary {
-			s.Render(r, canvasBox, xrange, yrange, c.styleDefaultsSeries(seriesIndex))
+			s.Render(r, canvasBox, xrange, yrange, c.getSeriesDefaults(s, seriesIndex))
 		} else if s.GetYAxis() == YAxisSecondary {
-			s.Render(r, canvasBox, xrange, yrangeAlt, c.styleDefaultsSeries(seriesIndex))
+			s.Render(r, canvasBox, xrange, yrangeAlt, c.getSeriesDefaults(s, seriesIndex))
+		}
+		endGroup(r)
+	}
+}
+
+// getSeriesDefaults returns the style defaults of a series, which for a
+// series drawn for another series in the chart, such as an annotation of its
+// values, are the defaults of the other series.
+func (c Chart) getSeriesDefaults(s Series, seriesIndex int) Style {
+	if isp, isInnerSeriesProvider := s.(innerSeriesProvider); isInnerSeriesProvider {
+		inner := isp.getInnerSeries()
+		for index, other := range c.Series {
+			// series are not always comparable, so they are compared deeply.
+			if index != seriesIndex && reflect.DeepEqual(inner, other) {
+				return c.styleDefaultsSeries(index)
+			}
+		}
+	}
+	return c.styleDefaultsSeries(seriesIndex)
+}
+
+// getSeriesDrawOrder returns the indexes of the series in the order they
+// draw: those behind the others first, then in series order, then those in front.
+func (c Chart) getSeriesDrawOrder() []int {
+	order := make([]int, 0, len(c.Series))
+	for _, zOrder := range []ZOrder{ZOrderBehind, ZOrderUnset, ZOrderFront} {
+		for index, s := range c.Series {
+			if getZOrder(s) == zOrder {
+				order = append(order, index)
+			}
+		}
+	}
+	return order
+}
+
+// getGroup returns the group for a chart element.
+func (c Chart) getGroup(id string, classNames ...string) Group {
+	prefix := c.ID
+	if len(prefix) == 0 {
+		prefix = DefaultGroupIDPrefix
+	}
+	return Group{
+		ID:        prefix + "-" + id,
+		ClassName: joinClassNames(classNames...),
+	}
+}
+
+// getSeriesIDs returns a unique id suffix for each series, derived from the
+// series name where possible and from its position otherwise.
+func (c Chart) getSeriesIDs() []string {
+	ids := make([]string, len(c.Series))
+	used := map[string]bool{}
+	for index, s := range c.Series {
+		id := "series-" + slugify(s.GetName())
+		if id == "series-" || used[id] {
+			id = "series-" + strconv.Itoa(index+1)
+		}
+		for used[id] {
+			id += "-" + strconv.Itoa(index+1)
+		}
+		used[id] = true
+		ids[index] = id
+	}
+	return ids
+}
+
+// getSeriesLabel returns the series name, or its position if it has none.
+func (c Chart) getSeriesLabel(s Series, seriesIndex int) string {
+	if name := s.GetName(); len(name) > 0 {
+		return name
+	}
+	return fmt.Sprintf("Series %d", seriesIndex+1)
+}
+
+// getDescription returns the description or a summary of the visible series.
+func (c Chart) getDescription() string {
+	if len(c.Description) > 0 {
+		return c.Description
+	}
+	var labels []string
+	for index, s := range c.Series {
+		if !s.GetStyle().Hidden {
+			labels = append(labels, c.getSeriesLabel(s, index))
+		}
+	}
+	return describeItems("Chart", "series", "series", labels)
+}
+
+// addDataTables adds a table of values for each visible series.
+func (c Chart) addDataTables(r Renderer, xf, yf, yfa ValueFormatter) {
+	xheader, yheader := c.XAxis.Name, c.YAxis.Name
+	if len(xheader) == 0 {
+		xheader = "X"
+	}
+	if len(yheader) == 0 {
+		yheader = "Y"
+	}
+
+	for index, s := range c.Series {
+		if s.GetStyle().Hidden {
+			continue
+		}
+
+		sxf, syf := xf, yf
+		header := yheader
+		if s.GetYAxis() == YAxisSecondary {
+			syf = yfa
+			if len(c.YAxisSecondary.Name) > 0 {
+				header = c.YAxisSecondary.Name
+			}
+		}
+		if vfp, isVfp := s.(ValueFormatterProvider); isVfp {
+			sxf, syf = vfp.GetValueFormatters()
+		}
+		if sxf == nil {
+			sxf = FloatValueFormatter
+		}
+		if syf == nil {
+			syf = FloatValueFormatter
+		}
+
+		var values interface{} = s
+		if vsp, isSnapshot := s.(valuesSnapshotProvider); isSnapshot {
+			values = vsp.getValuesSnapshot()
+		}
+
+		table := DataTable{Caption: c.getSeriesLabel(s, index)}
+		switch typed := values.(type) {
+		case ValuesProvider:
+			table.Headers = []string{xheader, header}
+			for vi := 0; vi < typed.Len(); vi++ {
+				x, y := typed.GetValues(vi)
+				table.Rows = append(table.Rows, []string{sxf(x), syf(y)})
+			}
+		case BoundedValuesProvider:
+			table.Headers = []string{xheader, header + " (upper)", header + " (lower)"}
+			for vi := 0; vi < typed.Len(); vi++ {
+				x, y1, y2 := typed.GetBoundedValues(vi)
+				table.Rows = append(table.Rows, []string{sxf(x), syf(y1), syf(y2)})
+			}
+		default:
+			continue
 		}
+		addDataTable(r, table)
 	}
 }
 
 func (c Chart) drawTitle(r Renderer) {
 	if len(c.Title) > 0 && !c.TitleStyle.Hidden {
+		beginGroup(r, c.getGroup("title", "title", c.TitleStyle.ClassName))
+		defer endGroup(r)
 		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
 		r.SetFontColor(c.TitleStyle.GetFontColor(c.GetColorPalette().TextColor()))
 		titleFontSize := c.TitleStyle.GetFontSize(DefaultTitleFontSize)
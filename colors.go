@@ -1,6 +1,10 @@
 package chart
 
-import "github.com/userstyles-world/go-chart/v2/drawing"
+import (
+	"math"
+
+	"github.com/userstyles-world/go-chart/v2/drawing"
+)
 
 var (
 	// ColorWhite is white.
@@ -106,6 +110,27 @@ func GetAlternateColor(index int) drawing.Color {
 	return DefaultAlternateColors[finalIndex]
 }
 
+// ColorScale returns a ColorProvider that linearly interpolates between the
+// given color stops, mapping vmin to the first stop and vmax to the last.
+func ColorScale(stops ...drawing.Color) ColorProvider {
+	return func(v, vmin, vmax float64) drawing.Color {
+		if len(stops) == 0 {
+			return drawing.ColorTransparent
+		}
+		if len(stops) == 1 || vmax == vmin {
+			return stops[0]
+		}
+
+		ratio := math.Max(0, math.Min(1, (v-vmin)/(vmax-vmin)))
+		position := ratio * float64(len(stops)-1)
+		index := int(math.Floor(position))
+		if index >= len(stops)-1 {
+			return stops[len(stops)-1]
+		}
+		return stops[index].Interpolate(stops[index+1], position-float64(index))
+	}
+}
+
 // ColorPalette is a set of colors that.
 type ColorPalette interface {
 	BackgroundColor() drawing.Color
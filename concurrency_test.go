@@ -0,0 +1,185 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	testConcurrentRenders = 200
+	testConcurrentWidth   = 320
+	testConcurrentHeight  = 240
+)
+
+// testRenderConcurrently runs the render func from many goroutines at once and
+// fails on the first error; run with `go test -race` to detect data races.
+func testRenderConcurrently(t *testing.T, render func(index int, rp RendererProvider, buffer *bytes.Buffer) error) {
+	var wg sync.WaitGroup
+	errors := make(chan error, testConcurrentRenders)
+
+	for index := 0; index < testConcurrentRenders; index++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			rp := PNG
+			if index%2 == 1 {
+				rp = SVG
+			}
+			buffer := bytes.NewBuffer([]byte{})
+			if err := render(index, rp, buffer); err != nil {
+				errors <- err
+				return
+			}
+			if buffer.Len() == 0 {
+				errors <- fmt.Errorf("render %d produced no output", index)
+			}
+		}(index)
+	}
+
+	wg.Wait()
+	close(errors)
+	for err := range errors {
+		testutil.AssertNil(t, err)
+	}
+}
+
+func TestChartRenderConcurrent(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+	testRenderConcurrently(t, func(index int, rp RendererProvider, buffer *bytes.Buffer) error {
+		var xvalues []time.Time
+		var yvalues []float64
+		for x := 0; x < 50; x++ {
+			// vary the values per render so the formatting caches are exercised.
+			xvalues = append(xvalues, start.Add(time.Duration(index*50+x)*time.Hour))
+			yvalues = append(yvalues, float64((index+x)%17)+0.25)
+		}
+
+		c := Chart{
+			Title:  fmt.Sprintf("Chart %d", index),
+			Width:  testConcurrentWidth,
+			Height: testConcurrentHeight,
+			XAxis: XAxis{
+				Name:           "Time",
+				ValueFormatter: TimeValueFormatter,
+			},
+			YAxis: YAxis{
+				Name: "Value",
+			},
+			Series: []Series{
+				TimeSeries{
+					Name:    "Values",
+					XValues: xvalues,
+					YValues: yvalues,
+				},
+			},
+		}
+		c.Elements = []Renderable{Legend(&c)}
+		return c.Render(rp, buffer)
+	})
+}
+
+func TestChartRenderConcurrentFontSizes(t *testing.T) {
+	// replaced new assertions helper
+
+	testRenderConcurrently(t, func(index int, rp RendererProvider, buffer *bytes.Buffer) error {
+		fontSize := float64(8 + index%8)
+		c := Chart{
+			Width:  testConcurrentWidth,
+			Height: testConcurrentHeight,
+			XAxis: XAxis{
+				Style: Style{FontSize: fontSize},
+			},
+			YAxis: YAxis{
+				Style: Style{FontSize: fontSize},
+			},
+			Series: []Series{
+				ContinuousSeries{
+					XValues: LinearRange(1, 20),
+					YValues: LinearRange(float64(index), float64(index)+19),
+				},
+			},
+		}
+		return c.Render(rp, buffer)
+	})
+}
+
+func TestBarChartRenderConcurrent(t *testing.T) {
+	// replaced new assertions helper
+
+	testRenderConcurrently(t, func(index int, rp RendererProvider, buffer *bytes.Buffer) error {
+		bc := BarChart{
+			Title:        fmt.Sprintf("Bar Chart %d", index),
+			Width:        testConcurrentWidth,
+			Height:       testConcurrentHeight,
+			IsHorizontal: index%4 < 2,
+			Bars: []Value{
+				{Value: float64(index), Label: "One"},
+				{Value: float64(index * 2), Label: "Two"},
+				{Value: float64(index + 3), Label: "Three"},
+			},
+		}
+		return bc.Render(rp, buffer)
+	})
+}
+
+func TestPieChartRenderConcurrent(t *testing.T) {
+	// replaced new assertions helper
+
+	testRenderConcurrently(t, func(index int, rp RendererProvider, buffer *bytes.Buffer) error {
+		pc := PieChart{
+			Width:  testConcurrentWidth,
+			Height: testConcurrentHeight,
+			Values: []Value{
+				{Value: float64(index + 1), Label: "One"},
+				{Value: 5, Label: "Two"},
+			},
+		}
+		return pc.Render(rp, buffer)
+	})
+}
+
+func TestStringCache(t *testing.T) {
+	// replaced new assertions helper
+
+	sc := newStringCache(2)
+	calls := 0
+	format := func() string {
+		calls++
+		return "value"
+	}
+
+	testutil.AssertEqual(t, "value", sc.GetOrAdd(1, format))
+	testutil.AssertEqual(t, "value", sc.GetOrAdd(1, format))
+	testutil.AssertEqual(t, 1, calls)
+
+	sc.GetOrAdd(2, format)
+	testutil.AssertEqual(t, 2, sc.Len())
+	sc.GetOrAdd(3, format)
+	testutil.AssertEqual(t, 1, sc.Len())
+}
+
+func TestGlyphAdvanceCacheBounded(t *testing.T) {
+	// replaced new assertions helper
+
+	for c := rune(0); c <= DefaultGlyphCacheCapacity; c++ {
+		setGlyphAdvance("bounded", c, 1)
+	}
+
+	glyphAdvancesMutex.RLock()
+	size := len(glyphAdvances)
+	glyphAdvancesMutex.RUnlock()
+	testutil.AssertTrue(t, size <= DefaultGlyphCacheCapacity, size)
+
+	advance, ok := getGlyphAdvance("bounded", DefaultGlyphCacheCapacity)
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, fixed.Int26_6(1), advance)
+}
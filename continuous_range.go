@@ -5,6 +5,12 @@ import (
 	"math"
 )
 
+// Interface Assertions.
+var (
+	_ Range  = (*ContinuousRange)(nil)
+	_ RangeF = (*ContinuousRange)(nil)
+)
+
 // ContinuousRange represents a boundary for a set of numbers.
 type ContinuousRange struct {
 	Min        float64
@@ -79,3 +85,13 @@ func (r ContinuousRange) Translate(value float64) int {
 
 	return int(math.Ceil(ratio * float64(r.Domain)))
 }
+
+// TranslateF maps a given value into the ContinuousRange space without rounding.
+func (r ContinuousRange) TranslateF(value float64) float64 {
+	ratio := (value - r.Min) / r.GetDelta()
+
+	if r.IsDescending() {
+		return float64(r.Domain) - ratio*float64(r.Domain)
+	}
+	return ratio * float64(r.Domain)
+}
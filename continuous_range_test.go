@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"math"
 	"testing"
 
 	"github.com/userstyles-world/go-chart/v2/testutil"
@@ -20,3 +21,43 @@ func TestRangeTranslate(t *testing.T) {
 	testutil.AssertEqual(t, 1000, r.Translate(8.0))
 	testutil.AssertEqual(t, 572, r.Translate(5.0))
 }
+
+func TestRangeTranslateF(t *testing.T) {
+	// replaced new assertions helper
+	r := ContinuousRange{Min: 1, Max: 8, Domain: 1000}
+
+	testutil.AssertEqual(t, 0.0, r.TranslateF(1.0))
+	testutil.AssertEqual(t, 1000.0, r.TranslateF(8.0))
+	testutil.AssertInDelta(t, 571.43, r.TranslateF(5.0), 0.01)
+	testutil.AssertEqual(t, 572, int(math.Ceil(r.TranslateF(5.0))))
+
+	r.Descending = true
+	testutil.AssertEqual(t, 1000.0, r.TranslateF(1.0))
+	testutil.AssertInDelta(t, 428.57, r.TranslateF(5.0), 0.01)
+}
+
+func TestTranslateFFallback(t *testing.T) {
+	// replaced new assertions helper
+
+	// ranges that don't implement RangeF are translated in whole pixels.
+	var r Range = &intRange{ContinuousRange{Min: 1, Max: 8, Domain: 1000}}
+	testutil.AssertEqual(t, 572.0, translateF(r, 5.0))
+	testutil.AssertInDelta(t, 571.43, translateF(&ContinuousRange{Min: 1, Max: 8, Domain: 1000}, 5.0), 0.01)
+}
+
+// intRange wraps a range without exposing its TranslateF method.
+type intRange struct {
+	cr ContinuousRange
+}
+
+func (ir *intRange) String() string              { return ir.cr.String() }
+func (ir *intRange) IsZero() bool                { return ir.cr.IsZero() }
+func (ir *intRange) GetMin() float64             { return ir.cr.GetMin() }
+func (ir *intRange) SetMin(min float64)          { ir.cr.SetMin(min) }
+func (ir *intRange) GetMax() float64             { return ir.cr.GetMax() }
+func (ir *intRange) SetMax(max float64)          { ir.cr.SetMax(max) }
+func (ir *intRange) GetDelta() float64           { return ir.cr.GetDelta() }
+func (ir *intRange) GetDomain() int              { return ir.cr.GetDomain() }
+func (ir *intRange) SetDomain(domain int)        { ir.cr.SetDomain(domain) }
+func (ir *intRange) IsDescending() bool          { return ir.cr.IsDescending() }
+func (ir *intRange) Translate(value float64) int { return ir.cr.Translate(value) }
@@ -0,0 +1,320 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                 = (*DecimatedSeries)(nil)
+	_ FirstValuesProvider    = (*DecimatedSeries)(nil)
+	_ LastValuesProvider     = (*DecimatedSeries)(nil)
+	_ ValueFormatterProvider = (*DecimatedSeries)(nil)
+)
+
+// DecimationMode is an enum for the downsampling algorithms.
+type DecimationMode int
+
+const (
+	// DecimationModeLTTB downsamples with Largest-Triangle-Three-Buckets,
+	// which preserves the visual shape of the series.
+	DecimationModeLTTB DecimationMode = 0
+	// DecimationModeMinMax keeps the min and max value per pixel column,
+	// which preserves every peak and trough of the series.
+	DecimationModeMinMax DecimationMode = 1
+)
+
+// DecimatedSeries draws a downsampled copy of an inner series.
+// The ranges are still computed from every value of the inner series; only
+// the points drawn are reduced, to at most Threshold, or by default about one
+// (LTTB) or two (min/max) per pixel of canvas width.
+type DecimatedSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Mode      DecimationMode
+	Threshold int
+
+	InnerSeries ValuesProvider
+}
+
+// GetName returns the name of the series.
+func (ds DecimatedSeries) GetName() string {
+	return ds.Name
+}
+
+// GetStyle returns the line style.
+func (ds DecimatedSeries) GetStyle() Style {
+	return ds.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ds DecimatedSeries) GetYAxis() YAxisType {
+	return ds.YAxis
+}
+
+// Len returns the number of elements in the inner series.
+func (ds DecimatedSeries) Len() int {
+	return ds.InnerSeries.Len()
+}
+
+// GetValues gets a value from the inner series.
+func (ds DecimatedSeries) GetValues(index int) (x, y float64) {
+	return ds.InnerSeries.GetValues(index)
+}
+
+// GetFirstValues gets the first values of the inner series.
+func (ds DecimatedSeries) GetFirstValues() (x, y float64) {
+	return ds.InnerSeries.GetValues(0)
+}
+
+// GetLastValues gets the last values of the inner series.
+func (ds DecimatedSeries) GetLastValues() (x, y float64) {
+	return ds.InnerSeries.GetValues(ds.InnerSeries.Len() - 1)
+}
+
+// GetValueFormatters returns the value formatters of the inner series, if it provides them.
+func (ds DecimatedSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if vfp, isValueFormatterProvider := ds.InnerSeries.(ValueFormatterProvider); isValueFormatterProvider {
+		return vfp.GetValueFormatters()
+	}
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+// GetThreshold returns the maximum number of points to draw for a given canvas width.
+func (ds DecimatedSeries) GetThreshold(canvasWidth int) int {
+	if ds.Threshold > 0 {
+		return ds.Threshold
+	}
+	if ds.Mode == DecimationModeMinMax {
+		return canvasWidth << 1
+	}
+	return canvasWidth
+}
+
+// Render renders the series.
+func (ds DecimatedSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ds.Style.InheritFrom(defaults)
+
+	// keep the name and formatters for hit targets.
+	decimated := ds.decimate(canvasBox, xrange)
+	decimated.Name = ds.Name
+	decimated.XValueFormatter, decimated.YValueFormatter = ds.GetValueFormatters()
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, decimated)
+}
+
+func (ds DecimatedSeries) decimate(canvasBox Box, xrange Range) ContinuousSeries {
+	threshold := ds.GetThreshold(canvasBox.Width())
+	if ds.Mode == DecimationModeMinMax {
+		buckets := MaxInt(1, threshold>>1)
+		if ds.Threshold == 0 {
+			// bucket by pixel column.
+			return decimateMinMax(ds.InnerSeries, buckets, xrange.Translate)
+		}
+		return DecimateMinMax(ds.InnerSeries, buckets)
+	}
+	return DecimateLTTB(ds.InnerSeries, threshold)
+}
+
+// Validate validates the series.
+func (ds DecimatedSeries) Validate() error {
+	if ds.InnerSeries == nil {
+		return fmt.Errorf("decimated series requires InnerSeries to be set")
+	}
+	if ds.Threshold < 0 {
+		return fmt.Errorf("decimated series requires a non-negative Threshold")
+	}
+	return nil
+}
+
+// DecimateLTTB downsamples the values to at most threshold points with the
+// Largest-Triangle-Three-Buckets algorithm.
+// The first and last values are always kept. If the series has no more than
+// threshold values, or threshold is less than 3, every value is kept.
+// NaN values are never picked; a bucket with a gap keeps its first NaN value
+// so the gap is still drawn, which can add a point per gap.
+func DecimateLTTB(vp ValuesProvider, threshold int) ContinuousSeries {
+	length := vp.Len()
+	if threshold < 3 || length <= threshold {
+		return copyValues(vp)
+	}
+
+	xvalues := make([]float64, 0, threshold)
+	yvalues := make([]float64, 0, threshold)
+	appendValues := func(x, y float64) {
+		// consecutive gaps are drawn as one.
+		if math.IsNaN(y) && len(yvalues) > 0 && math.IsNaN(yvalues[len(yvalues)-1]) {
+			return
+		}
+		xvalues = append(xvalues, x)
+		yvalues = append(yvalues, y)
+	}
+
+	ax, ay := vp.GetValues(0)
+	appendValues(ax, ay)
+
+	// the first and last buckets hold only the first and last values.
+	bucketSize := float64(length-2) / float64(threshold-2)
+
+	for bucket := 0; bucket < threshold-2; bucket++ {
+		// the average of the next bucket is the third point of the triangle.
+		nextStart := int(math.Floor(float64(bucket+1)*bucketSize)) + 1
+		nextEnd := MinInt(int(math.Floor(float64(bucket+2)*bucketSize))+1, length)
+		var avgx, avgy float64
+		var count int
+		for index := nextStart; index < nextEnd; index++ {
+			x, y := vp.GetValues(index)
+			if math.IsNaN(x) || math.IsNaN(y) {
+				continue
+			}
+			avgx += x
+			avgy += y
+			count++
+		}
+		if count > 0 {
+			avgx /= float64(count)
+			avgy /= float64(count)
+		} else {
+			avgx, avgy = vp.GetValues(length - 1)
+		}
+
+		// pick the point in this bucket forming the largest triangle.
+		start := int(math.Floor(float64(bucket)*bucketSize)) + 1
+		end := int(math.Floor(float64(bucket+1)*bucketSize)) + 1
+		maxArea := -1.0
+		best, gap := -1, -1
+		var bx, by, gx float64
+		for index := start; index < end; index++ {
+			x, y := vp.GetValues(index)
+			if math.IsNaN(y) {
+				if gap < 0 {
+					gap, gx = index, x
+				}
+				continue
+			}
+			if math.IsNaN(x) {
+				continue
+			}
+			area := math.Abs((ax-avgx)*(y-ay) - (ax-x)*(avgy-ay))
+			if math.IsNaN(area) {
+				// the anchor or the average is a gap, so any point will do.
+				area = 0
+			}
+			if area > maxArea {
+				maxArea = area
+				best, bx, by = index, x, y
+			}
+		}
+
+		if gap >= 0 && (best < 0 || gap < best) {
+			appendValues(gx, math.NaN())
+		}
+		if best >= 0 {
+			appendValues(bx, by)
+			ax, ay = bx, by
+		}
+		if gap > best && best >= 0 {
+			appendValues(gx, math.NaN())
+		}
+	}
+
+	lx, ly := vp.GetValues(length - 1)
+	appendValues(lx, ly)
+
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
+
+// DecimateMinMax downsamples the values by splitting the x range into equal
+// width buckets and keeping the min and max value of each, in their original
+// order, which yields at most 2*buckets points (plus the first and last values).
+// The values are assumed to be sorted by x.
+func DecimateMinMax(vp ValuesProvider, buckets int) ContinuousSeries {
+	length := vp.Len()
+	if buckets < 1 || length <= buckets<<1 {
+		return copyValues(vp)
+	}
+
+	x0, _ := vp.GetValues(0)
+	xn, _ := vp.GetValues(length - 1)
+	delta := xn - x0
+	if delta == 0 {
+		return copyValues(vp)
+	}
+	return decimateMinMax(vp, buckets, func(x float64) int {
+		return int(math.Floor((x - x0) / delta * float64(buckets)))
+	})
+}
+
+// decimateMinMax keeps the min and max value of each run of values that fall
+// into the same bucket.
+func decimateMinMax(vp ValuesProvider, buckets int, bucketOf func(float64) int) ContinuousSeries {
+	length := vp.Len()
+	if length == 0 {
+		return ContinuousSeries{}
+	}
+
+	xvalues := make([]float64, 0, MinInt(length, (buckets<<1)+2))
+	yvalues := make([]float64, 0, MinInt(length, (buckets<<1)+2))
+	appendIndex := func(index int) {
+		x, y := vp.GetValues(index)
+		xvalues = append(xvalues, x)
+		yvalues = append(yvalues, y)
+	}
+
+	var minIndex, maxIndex int
+	var miny, maxy float64
+	flush := func() {
+		first, second := minIndex, maxIndex
+		if first > second {
+			first, second = second, first
+		}
+		appendIndex(first)
+		if second != first {
+			appendIndex(second)
+		}
+	}
+
+	appendIndex(0)
+
+	var current int
+	var hasBucket bool
+	for index := 1; index < length-1; index++ {
+		x, y := vp.GetValues(index)
+		bucket := bucketOf(x)
+		if !hasBucket || bucket != current {
+			if hasBucket {
+				flush()
+			}
+			current, hasBucket = bucket, true
+			minIndex, maxIndex = index, index
+			miny, maxy = y, y
+			continue
+		}
+		if y < miny {
+			minIndex, miny = index, y
+		}
+		if y > maxy {
+			maxIndex, maxy = index, y
+		}
+	}
+	if hasBucket {
+		flush()
+	}
+
+	if length > 1 {
+		appendIndex(length - 1)
+	}
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
+
+func copyValues(vp ValuesProvider) ContinuousSeries {
+	length := vp.Len()
+	xvalues := make([]float64, length)
+	yvalues := make([]float64, length)
+	for index := 0; index < length; index++ {
+		xvalues[index], yvalues[index] = vp.GetValues(index)
+	}
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
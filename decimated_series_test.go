@@ -0,0 +1,197 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testDecimationValues(length int) ContinuousSeries {
+	xvalues := make([]float64, length)
+	yvalues := make([]float64, length)
+	// a seeded random walk, so every run draws the same series.
+	random := rand.New(rand.NewSource(1))
+	var y float64
+	for index := 0; index < length; index++ {
+		y += random.NormFloat64()
+		xvalues[index] = float64(index)
+		yvalues[index] = y
+	}
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
+
+func TestDecimateLTTB(t *testing.T) {
+	// replaced new assertions helper
+
+	values := testDecimationValues(10000)
+	decimated := DecimateLTTB(values, 100)
+	testutil.AssertEqual(t, 100, decimated.Len())
+
+	x0, y0 := decimated.GetFirstValues()
+	testutil.AssertEqual(t, 0.0, x0)
+	testutil.AssertEqual(t, values.YValues[0], y0)
+	xn, yn := decimated.GetLastValues()
+	testutil.AssertEqual(t, 9999.0, xn)
+	testutil.AssertEqual(t, values.YValues[9999], yn)
+
+	for index := 1; index < decimated.Len(); index++ {
+		testutil.AssertTrue(t, decimated.XValues[index] > decimated.XValues[index-1])
+	}
+}
+
+func TestDecimateLTTBGaps(t *testing.T) {
+	// replaced new assertions helper
+
+	values := ContinuousSeries{
+		XValues: LinearRangeWithStep(1000, 1099, 1),
+		YValues: make([]float64, 100),
+	}
+	for index := range values.YValues {
+		values.YValues[index] = 50
+		if index >= 20 && index < 60 {
+			values.YValues[index] = math.NaN()
+		}
+	}
+
+	decimated := DecimateLTTB(values, 10)
+	var gaps int
+	for index, y := range decimated.YValues {
+		// no points are made up at the origin.
+		testutil.AssertTrue(t, decimated.XValues[index] >= 1000, decimated.XValues)
+		if math.IsNaN(y) {
+			gaps++
+			continue
+		}
+		testutil.AssertEqual(t, 50.0, y)
+	}
+	// the gap is carried through, once.
+	testutil.AssertEqual(t, 1, gaps)
+}
+
+func TestDecimateLTTBPassthrough(t *testing.T) {
+	// replaced new assertions helper
+
+	values := testDecimationValues(50)
+	testutil.AssertEqual(t, 50, DecimateLTTB(values, 100).Len())
+	testutil.AssertEqual(t, 50, DecimateLTTB(values, 2).Len())
+}
+
+func TestDecimateMinMax(t *testing.T) {
+	// replaced new assertions helper
+
+	values := testDecimationValues(10000)
+	decimated := DecimateMinMax(values, 100)
+	testutil.AssertTrue(t, decimated.Len() <= 202)
+
+	x0, _ := decimated.GetFirstValues()
+	testutil.AssertEqual(t, 0.0, x0)
+	xn, _ := decimated.GetLastValues()
+	testutil.AssertEqual(t, 9999.0, xn)
+
+	// the envelope keeps the extremes.
+	min, max := MinMax(values.YValues...)
+	dmin, dmax := MinMax(decimated.YValues...)
+	testutil.AssertEqual(t, min, dmin)
+	testutil.AssertEqual(t, max, dmax)
+
+	for index := 1; index < decimated.Len(); index++ {
+		testutil.AssertTrue(t, decimated.XValues[index] > decimated.XValues[index-1])
+	}
+}
+
+func TestDecimatedSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	values := testDecimationValues(20000)
+	for _, mode := range []DecimationMode{DecimationModeLTTB, DecimationModeMinMax} {
+		ds := DecimatedSeries{
+			Mode:        mode,
+			InnerSeries: values,
+		}
+		testutil.AssertNil(t, ds.Validate())
+
+		c := Chart{
+			Series: []Series{ds},
+		}
+		_, yr, _ := c.getRanges()
+		min, max := MinMax(values.YValues...)
+		testutil.AssertTrue(t, yr.GetMin() <= min)
+		testutil.AssertTrue(t, yr.GetMax() >= max)
+
+		decimated := ds.decimate(Box{Right: 1000}, &ContinuousRange{Min: 0, Max: 19999, Domain: 1000})
+		testutil.AssertTrue(t, decimated.Len() <= 2002)
+
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, c.Render(SVG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
+
+func TestDecimatedSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, DecimatedSeries{}.Validate())
+	testutil.AssertNotNil(t, DecimatedSeries{
+		InnerSeries: testDecimationValues(10),
+		Threshold:   -1,
+	}.Validate())
+}
+
+const benchmarkDecimationPoints = 100000
+
+func benchmarkDecimationRender(b *testing.B, rp RendererProvider, series Series) {
+	c := Chart{
+		Width:  1024,
+		Height: 400,
+		Series: []Series{series},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var size int
+	for i := 0; i < b.N; i++ {
+		buffer := bytes.NewBuffer([]byte{})
+		if err := c.Render(rp, buffer); err != nil {
+			b.Fatal(err)
+		}
+		size = buffer.Len()
+	}
+	b.ReportMetric(float64(size), "bytes/render")
+}
+
+func BenchmarkDecimationSVGRaw(b *testing.B) {
+	benchmarkDecimationRender(b, SVG, testDecimationValues(benchmarkDecimationPoints))
+}
+
+func BenchmarkDecimationSVGLTTB(b *testing.B) {
+	benchmarkDecimationRender(b, SVG, DecimatedSeries{
+		InnerSeries: testDecimationValues(benchmarkDecimationPoints),
+	})
+}
+
+func BenchmarkDecimationSVGMinMax(b *testing.B) {
+	benchmarkDecimationRender(b, SVG, DecimatedSeries{
+		Mode:        DecimationModeMinMax,
+		InnerSeries: testDecimationValues(benchmarkDecimationPoints),
+	})
+}
+
+func BenchmarkDecimationPNGRaw(b *testing.B) {
+	benchmarkDecimationRender(b, PNG, testDecimationValues(benchmarkDecimationPoints))
+}
+
+func BenchmarkDecimationPNGLTTB(b *testing.B) {
+	benchmarkDecimationRender(b, PNG, DecimatedSeries{
+		InnerSeries: testDecimationValues(benchmarkDecimationPoints),
+	})
+}
+
+func BenchmarkDecimationPNGMinMax(b *testing.B) {
+	benchmarkDecimationRender(b, PNG, DecimatedSeries{
+		Mode:        DecimationModeMinMax,
+		InnerSeries: testDecimationValues(benchmarkDecimationPoints),
+	})
+}
@@ -25,6 +25,8 @@ const (
 	DefaultAnnotationDeltaWidth = 10
 	// DefaultAnnotationFontSize is the font size of annotations.
 	DefaultAnnotationFontSize = 10.0
+	// DefaultAnnotationSpacing is the vertical gap left between annotations that are nudged apart.
+	DefaultAnnotationSpacing = 2
 	// DefaultAxisFontSize is the font size of the axis labels.
 	DefaultAxisFontSize = 10.0
 	// DefaultTitleTop is the default distance from the top of the chart to put the title.
@@ -64,6 +66,12 @@ const (
 	DefaultDateHourFormat = "01-02 3PM"
 	// DefaultDateMinuteFormat is the date format for minute range timestamp formats.
 	DefaultDateMinuteFormat = "01-02 3:04PM"
+	// DefaultDateSecondFormat is the date format for second range timestamp formats.
+	DefaultDateSecondFormat = "3:04:05PM"
+	// DefaultDateMonthFormat is the date format for month range timestamp formats.
+	DefaultDateMonthFormat = "2006-01"
+	// DefaultDateYearFormat is the date format for year range timestamp formats.
+	DefaultDateYearFormat = "2006"
 	// DefaultFloatFormat is the default float format.
 	DefaultFloatFormat = "%.2f"
 	// DefaultPercentValueFormat is the default percent format.
@@ -73,6 +81,32 @@ const (
 	DefaultBarSpacing = 100
 	// DefaultBarWidth is the default pixel width of bars in a bar chart.
 	DefaultBarWidth = 50
+
+	// DefaultDonutHoleSize is the default radius of a donut chart's hole
+	// as a ratio of the outer radius.
+	DefaultDonutHoleSize = 0.5
+
+	// DefaultCategoryBandFill is the ratio of a category band filled by a bar.
+	DefaultCategoryBandFill = 0.8
+
+	// DefaultReferenceLabelPadding is the distance between a reference line,
+	// band or region and its label.
+	DefaultReferenceLabelPadding = 5
+	// DefaultReferenceFillAlpha is the alpha of the default fill of bands and regions.
+	DefaultReferenceFillAlpha = 48
+
+	// DefaultHistogramMaxBinCount is a hard limit on the number of bins in a histogram.
+	DefaultHistogramMaxBinCount = 1 << 10 // 1024
+
+	// DefaultDistributionFillAlpha is the alpha of the default fill of boxes and violins.
+	DefaultDistributionFillAlpha = 96
+	// DefaultBoxPlotWhiskerIQR is how many interquartile ranges the whiskers
+	// of a box plot reach beyond the box.
+	DefaultBoxPlotWhiskerIQR = 1.5
+	// DefaultBoxPlotOutlierSize is the radius of the outlier dots of a box plot.
+	DefaultBoxPlotOutlierSize = 3.0
+	// DefaultViolinResolution is the number of points a violin's density is evaluated at.
+	DefaultViolinResolution = 50
 )
 
 var (
@@ -0,0 +1,115 @@
+package chart
+
+import (
+	"math"
+	"sort"
+)
+
+// DistributionGroup is a group of samples drawn as one box or violin.
+//
+// On a CategoryRange x axis a group is drawn in the band of its label, or
+// of its position in the series if the range has no such category; on other
+// x axes it is drawn at X.
+type DistributionGroup struct {
+	Label  string
+	X      float64
+	Values []float64
+}
+
+// distributionGroups are the groups of a box plot or violin series.
+type distributionGroups []DistributionGroup
+
+// categories returns the labels of the groups.
+func (dg distributionGroups) categories() []string {
+	categories := make([]string, len(dg))
+	for index, group := range dg {
+		categories[index] = group.Label
+	}
+	return categories
+}
+
+// samples returns the finite samples of a group, sorted.
+func (dg distributionGroups) samples(index int) Seq {
+	var samples []float64
+	for _, v := range dg[index].Values {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			samples = append(samples, v)
+		}
+	}
+	sort.Float64s(samples)
+	return ValueSequence(samples...)
+}
+
+// hasSamples returns if any group has a finite sample.
+func (dg distributionGroups) hasSamples() bool {
+	for index := range dg {
+		if dg.samples(index).Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// spacing returns the smallest distance between the x values of the groups,
+// or 1 if they are all at the same x.
+func (dg distributionGroups) spacing() float64 {
+	xs := make([]float64, len(dg))
+	for index, group := range dg {
+		xs[index] = group.X
+	}
+	sort.Float64s(xs)
+
+	spacing := math.Inf(1)
+	for index := 1; index < len(xs); index++ {
+		if delta := xs[index] - xs[index-1]; delta > 0 {
+			spacing = math.Min(spacing, delta)
+		}
+	}
+	if math.IsInf(spacing, 1) {
+		return 1
+	}
+	return spacing
+}
+
+// valueBounds returns the bounds of the groups' x values, padded by half the
+// spacing, and of their samples.
+func (dg distributionGroups) valueBounds() (minx, maxx, miny, maxy float64) {
+	minx, maxx = math.Inf(1), math.Inf(-1)
+	miny, maxy = math.Inf(1), math.Inf(-1)
+	for index, group := range dg {
+		minx = math.Min(minx, group.X)
+		maxx = math.Max(maxx, group.X)
+		if samples := dg.samples(index); samples.Len() > 0 {
+			miny = math.Min(miny, samples.GetValue(0))
+			maxy = math.Max(maxy, samples.GetValue(samples.Len()-1))
+		}
+	}
+	if math.IsInf(miny, 1) {
+		miny, maxy = 0, 0
+	}
+	halfSpacing := dg.spacing() / 2
+	return minx - halfSpacing, maxx + halfSpacing, miny, maxy
+}
+
+// center returns the x value a group is drawn at.
+func (dg distributionGroups) center(xrange Range, index int) float64 {
+	if cr, isCategoryRange := xrange.(*CategoryRange); isCategoryRange {
+		if categoryIndex := cr.IndexOf(dg[index].Label); categoryIndex >= 0 {
+			return float64(categoryIndex)
+		}
+		return float64(index)
+	}
+	return dg[index].X
+}
+
+// width returns the width in pixels of a fraction of the space for each group.
+func (dg distributionGroups) width(xrange Range, fill float64) float64 {
+	if cr, isCategoryRange := xrange.(*CategoryRange); isCategoryRange {
+		return float64(cr.GetBandWidth()) * fill
+	}
+	if len(dg) == 0 {
+		return 0
+	}
+	x := dg[0].X
+	return math.Abs(translateF(xrange, x+dg.spacing())-translateF(xrange, x)) * fill
+}
@@ -0,0 +1,61 @@
+package chart
+
+import (
+	"io"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// DonutChart is a PieChart with a hole, which draws sections of a ring based
+// on percentages. Its HoleSize defaults to `DefaultDonutHoleSize`.
+type DonutChart PieChart
+
+// GetDPI returns the dpi for the chart.
+func (dc DonutChart) GetDPI(defaults ...float64) float64 {
+	return dc.pieChart().GetDPI(defaults...)
+}
+
+// GetFont returns the text font.
+func (dc DonutChart) GetFont() *truetype.Font {
+	return dc.pieChart().GetFont()
+}
+
+// GetWidth returns the chart width or the default value.
+func (dc DonutChart) GetWidth() int {
+	return dc.pieChart().GetWidth()
+}
+
+// GetHeight returns the chart height or the default value.
+func (dc DonutChart) GetHeight() int {
+	return dc.pieChart().GetHeight()
+}
+
+// GetHoleSize returns the hole size ratio or the default value.
+func (dc DonutChart) GetHoleSize() float64 {
+	if dc.HoleSize <= 0 || dc.HoleSize >= 1 {
+		return DefaultDonutHoleSize
+	}
+	return dc.HoleSize
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (dc DonutChart) GetColorPalette() ColorPalette {
+	return dc.pieChart().GetColorPalette()
+}
+
+// Box returns the chart bounds as a box.
+func (dc DonutChart) Box() Box {
+	return dc.pieChart().Box()
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (dc DonutChart) Render(rp RendererProvider, w io.Writer) error {
+	return dc.pieChart().Render(rp, w)
+}
+
+// pieChart returns the pie chart the donut chart draws as.
+func (dc DonutChart) pieChart() PieChart {
+	pc := PieChart(dc)
+	pc.HoleSize = dc.GetHoleSize()
+	return pc
+}
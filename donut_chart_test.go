@@ -0,0 +1,127 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestDonutChart(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 10, Label: "Blue"},
+			{Value: 9, Label: "Green"},
+			{Value: 8, Label: "Gray"},
+			{Value: 7, Label: "Orange"},
+			{Value: 6, Label: "HEANG"},
+			{Value: 5, Label: "??"},
+			{Value: 2, Label: "!!"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, donut.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, donut.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestDonutChartSingleValue(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{
+		Values: []Value{
+			{Value: 10, Label: "Only"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, donut.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestDonutChartDropsZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 5, Label: "Blue"},
+			{Value: 5, Label: "Green"},
+			{Value: 0, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, donut.Render(PNG, b))
+}
+
+func TestDonutChartAllZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 0, Label: "Blue"},
+			{Value: 0, Label: "Green"},
+			{Value: 0, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, donut.Render(PNG, b))
+}
+
+func TestDonutChartNoValues(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, donut.Render(PNG, b))
+}
+
+func TestDonutChartHoleSize(t *testing.T) {
+	// replaced new assertions helper
+
+	donut := DonutChart{}
+	testutil.AssertEqual(t, DefaultDonutHoleSize, donut.GetHoleSize())
+
+	donut.HoleSize = 0.25
+	testutil.AssertEqual(t, 0.25, donut.GetHoleSize())
+
+	donut.HoleSize = 1.5
+	testutil.AssertEqual(t, DefaultDonutHoleSize, donut.GetHoleSize())
+}
+
+func TestDonutChartIsPieChartWithHole(t *testing.T) {
+	// replaced new assertions helper
+
+	values := []Value{
+		{Value: 5, Label: "Blue"},
+		{Value: 5, Label: "Green"},
+	}
+
+	donut := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, DonutChart{Values: values}.Render(SVG, donut))
+	testutil.AssertContains(t, donut.String(), "<desc>Donut chart with 2 slices: Blue, Green.</desc>")
+
+	pie := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, PieChart{Values: values, HoleSize: DefaultDonutHoleSize}.Render(SVG, pie))
+	testutil.AssertEqual(t, donut.String(), pie.String())
+
+	testutil.AssertZero(t, PieChart{}.GetHoleSize())
+	testutil.AssertZero(t, PieChart{HoleSize: 1.5}.GetHoleSize())
+}
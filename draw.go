@@ -12,48 +12,43 @@ var (
 type draw struct{}
 
 // LineSeries draws a line series with a renderer.
+// Coordinates are at float precision if the renderer implements RendererF.
 func (draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider) {
 	if vs.Len() == 0 {
 		return
 	}
 
-	cb := canvasBox.Bottom
-	cl := canvasBox.Left
-
-	v0x, v0y := vs.GetValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y)
-
-	yv0 := yrange.Translate(0)
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
 
+	xs := make([]float64, vs.Len())
+	ys := make([]float64, vs.Len())
 	var vx, vy float64
-	var x, y int
+	for i := 0; i < vs.Len(); i++ {
+		vx, vy = vs.GetValues(i)
+		xs[i] = sp.X(vx)
+		ys[i] = sp.Y(vy)
+	}
+	x0, y0 := xs[0], ys[0]
+	interpolation := style.GetInterpolation()
+
+	// fills extend to zero, or to the bottom of the canvas if zero is below it.
+	base := math.Min(float64(canvasBox.Bottom), sp.Y(0))
 
 	if style.ShouldDrawStroke() && style.ShouldDrawFill() {
 		style.GetFillOptions().WriteDrawingOptionsToRenderer(r)
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
-		}
-		r.LineTo(x, MinInt(cb, cb-yv0))
-		r.LineTo(x0, MinInt(cb, cb-yv0))
-		r.LineTo(x0, y0)
+		sp.MoveTo(x0, y0)
+		traceInterpolated(sp, interpolation, xs, ys)
+		sp.LineTo(xs[len(xs)-1], base)
+		sp.LineTo(x0, base)
+		sp.LineTo(x0, y0)
 		r.Fill()
 	}
 
 	if style.ShouldDrawStroke() {
 		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
 
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
-		}
+		sp.MoveTo(x0, y0)
+		traceInterpolated(sp, interpolation, xs, ys)
 		r.Stroke()
 	}
 
@@ -63,8 +58,6 @@ func (draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style St
 		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
 		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
 
 			dotWidth := defaultDotWidth
 			if style.DotWidthProvider != nil {
@@ -78,28 +71,42 @@ func (draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style St
 				r.SetStrokeColor(dotColor)
 			}
 
-			r.Circle(dotWidth, x, y)
+			sp.Circle(dotWidth, xs[i], ys[i])
 			r.FillStroke()
 		}
 	}
+
+	if htr, ok := getHitTargetRenderer(r); ok {
+		htf := newHitTargetFormatter(vs)
+		radius := int(math.Ceil(math.Max(style.GetDotWidth(), DefaultHitTargetRadius)))
+		for i := 0; i < vs.Len(); i++ {
+			vx, vy = vs.GetValues(i)
+			cx := int(math.Round(xs[i]))
+			cy := int(math.Round(ys[i]))
+
+			target := htf.target(Box{Top: cy - radius, Left: cx - radius, Right: cx + radius, Bottom: cy + radius}, vx, vy)
+			target.Round = true
+			htr.HitTarget(target)
+		}
+	}
 }
 
 // BoundedSeries draws a series that implements BoundedValuesProvider.
+// Coordinates are at float precision if the renderer implements RendererF.
 func (draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, bbs BoundedValuesProvider, drawOffsetIndexes ...int) {
 	drawOffsetIndex := 0
 	if len(drawOffsetIndexes) > 0 {
 		drawOffsetIndex = drawOffsetIndexes[0]
 	}
 
-	cb := canvasBox.Bottom
-	cl := canvasBox.Left
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
 
 	v0x, v0y1, v0y2 := bbs.GetBoundedValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y1)
+	x0 := sp.X(v0x)
+	y0 := sp.Y(v0y1)
 
 	var vx, vy1, vy2 float64
-	var x, y int
+	var x, y float64
 
 	xvalues := make([]float64, bbs.Len())
 	xvalues[0] = v0x
@@ -107,28 +114,28 @@ func (draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	y2values[0] = v0y2
 
 	style.GetFillAndStrokeOptions().WriteToRenderer(r)
-	r.MoveTo(x0, y0)
+	sp.MoveTo(x0, y0)
 	for i := 1; i < bbs.Len(); i++ {
 		vx, vy1, vy2 = bbs.GetBoundedValues(i)
 
 		xvalues[i] = vx
 		y2values[i] = vy2
 
-		x = cl + xrange.Translate(vx)
-		y = cb - yrange.Translate(vy1)
+		x = sp.X(vx)
+		y = sp.Y(vy1)
 		if i > drawOffsetIndex {
-			r.LineTo(x, y)
+			sp.LineTo(x, y)
 		} else {
-			r.MoveTo(x, y)
+			sp.MoveTo(x, y)
 		}
 	}
-	y = cb - yrange.Translate(vy2)
-	r.LineTo(x, y)
+	y = sp.Y(vy2)
+	sp.LineTo(x, y)
 	for i := bbs.Len() - 1; i >= drawOffsetIndex; i-- {
 		vx, vy2 = xvalues[i], y2values[i]
-		x = cl + xrange.Translate(vx)
-		y = cb - yrange.Translate(vy2)
-		r.LineTo(x, y)
+		x = sp.X(vx)
+		y = sp.Y(vy2)
+		sp.LineTo(x, y)
 	}
 	r.Close()
 	r.FillStroke()
@@ -150,6 +157,12 @@ func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, s
 	cb := canvasBox.Bottom
 	cl := canvasBox.Left
 
+	htr, drawHitTargets := getHitTargetRenderer(r)
+	var htf hitTargetFormatter
+	if drawHitTargets {
+		htf = newHitTargetFormatter(vs)
+	}
+
 	// foreach datapoint, draw a box.
 	for index := 0; index < seriesLength; index++ {
 		vx, vy := vs.GetValues(index)
@@ -163,6 +176,15 @@ func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, s
 			Right:  x + (barWidth >> 1),
 			Bottom: cb - y,
 		}, style)
+
+		if drawHitTargets {
+			htr.HitTarget(htf.target(Box{
+				Top:    MinInt(cb-y0, cb-y),
+				Left:   x - (barWidth >> 1),
+				Right:  x + (barWidth >> 1),
+				Bottom: MaxInt(cb-y0, cb-y),
+			}, vx, vy))
+		}
 	}
 }
 
@@ -323,3 +345,131 @@ func (draw) TextWithin(r Renderer, text string, box Box, style Style) {
 		y += lineBox.Height() + style.GetTextLineSpacing()
 	}
 }
+
+// Marker draws a marker shape centered on a given point, with size being the
+// distance from the center to the edge of the shape.
+// The fill and stroke options must already be set on the renderer.
+func (draw) Marker(r Renderer, shape MarkerShape, x, y int, size float64) {
+	s := int(math.Round(size))
+	switch shape {
+	case MarkerShapeSquare:
+		r.MoveTo(x-s, y-s)
+		r.LineTo(x+s, y-s)
+		r.LineTo(x+s, y+s)
+		r.LineTo(x-s, y+s)
+		r.Close()
+		r.FillStroke()
+	case MarkerShapeTriangle:
+		// an equilateral triangle inscribed in the circle of radius `size`.
+		half := int(math.Round(size * math.Sqrt(3) / 2))
+		bottom := int(math.Round(size / 2))
+		r.MoveTo(x, y-s)
+		r.LineTo(x+half, y+bottom)
+		r.LineTo(x-half, y+bottom)
+		r.Close()
+		r.FillStroke()
+	case MarkerShapeDiamond:
+		r.MoveTo(x, y-s)
+		r.LineTo(x+s, y)
+		r.LineTo(x, y+s)
+		r.LineTo(x-s, y)
+		r.Close()
+		r.FillStroke()
+	case MarkerShapeCross:
+		r.MoveTo(x-s, y-s)
+		r.LineTo(x+s, y+s)
+		r.MoveTo(x+s, y-s)
+		r.LineTo(x-s, y+s)
+		r.Stroke()
+	default:
+		r.Circle(size, x, y)
+		r.FillStroke()
+	}
+}
+
+// seriesPath maps values to canvas coordinates and draws paths through them,
+// at float precision if the renderer implements RendererF and in whole pixels
+// otherwise.
+type seriesPath struct {
+	r         Renderer
+	rf        RendererF
+	canvasBox Box
+	xrange    Range
+	yrange    Range
+}
+
+func newSeriesPath(r Renderer, canvasBox Box, xrange, yrange Range) seriesPath {
+	sp := seriesPath{
+		r:         r,
+		canvasBox: canvasBox,
+		xrange:    xrange,
+		yrange:    yrange,
+	}
+	sp.rf, _ = getRendererF(r)
+	return sp
+}
+
+// getRendererF returns the renderer as a RendererF if it supports float coordinates.
+func getRendererF(r Renderer) (RendererF, bool) {
+	// the limited renderer counts float coordinates, if the renderer it wraps accepts them.
+	if lr, isLimited := r.(*limitedRenderer); isLimited {
+		if rf, isRendererF := getRendererF(lr.Unwrap()); isRendererF {
+			return limitedRendererF{lr: lr, rf: rf}, true
+		}
+		return nil, false
+	}
+	rf, isRendererF := r.(RendererF)
+	return rf, isRendererF
+}
+
+// X returns the canvas x coordinate of a value.
+func (sp seriesPath) X(vx float64) float64 {
+	if sp.rf != nil {
+		return float64(sp.canvasBox.Left) + translateF(sp.xrange, vx)
+	}
+	return float64(sp.canvasBox.Left + sp.xrange.Translate(vx))
+}
+
+// Y returns the canvas y coordinate of a value.
+func (sp seriesPath) Y(vy float64) float64 {
+	if sp.rf != nil {
+		return float64(sp.canvasBox.Bottom) - translateF(sp.yrange, vy)
+	}
+	return float64(sp.canvasBox.Bottom - sp.yrange.Translate(vy))
+}
+
+// MoveTo moves the cursor to a given point.
+func (sp seriesPath) MoveTo(x, y float64) {
+	if sp.rf != nil {
+		sp.rf.MoveToF(x, y)
+		return
+	}
+	sp.r.MoveTo(int(x), int(y))
+}
+
+// LineTo draws a line to a given point from the previous point.
+func (sp seriesPath) LineTo(x, y float64) {
+	if sp.rf != nil {
+		sp.rf.LineToF(x, y)
+		return
+	}
+	sp.r.LineTo(int(x), int(y))
+}
+
+// CubicCurveTo draws a cubic bezier curve from the previous point.
+func (sp seriesPath) CubicCurveTo(cx1, cy1, cx2, cy2, x, y float64) {
+	if sp.rf != nil {
+		sp.rf.CubicCurveToF(cx1, cy1, cx2, cy2, x, y)
+		return
+	}
+	sp.r.CubicCurveTo(int(cx1), int(cy1), int(cx2), int(cy2), int(x), int(y))
+}
+
+// Circle draws a circle at the given coords with a given radius.
+func (sp seriesPath) Circle(radius, x, y float64) {
+	if sp.rf != nil {
+		sp.rf.CircleF(radius, x, y)
+		return
+	}
+	sp.r.Circle(radius, int(x), int(y))
+}
@@ -0,0 +1,99 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+// intRenderer wraps a renderer without exposing its optional extensions.
+type intRenderer struct {
+	Renderer
+}
+
+func TestDrawLineSeriesFloat(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	xrange := &ContinuousRange{Min: 0, Max: 3, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 3, Domain: 100}
+	cs := ContinuousSeries{
+		XValues: []float64{0, 1, 2, 3},
+		YValues: []float64{0, 1, 2, 3},
+	}
+	style := Style{StrokeColor: ColorBlue, StrokeWidth: 1}
+
+	Draw.LineSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100L 33.33 66.67L 66.67 33.33L 100 0"`)
+
+	// renderers without float coordinates get whole pixels.
+	r, err = SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.LineSeries(intRenderer{r}, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100L 34 66L 67 33L 100 0"`)
+
+	// as do they when wrapped by render limits.
+	r, err = SVG(100, 100)
+	testutil.AssertNil(t, err)
+	lr, _ := RenderLimits{MaxPathCommands: 100}.wrap(intRenderer{r})
+	Draw.LineSeries(lr, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100L 34 66L 67 33L 100 0"`)
+}
+
+func TestDrawBoundedSeriesFloat(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	xrange := &ContinuousRange{Min: 0, Max: 3, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 3, Domain: 100}
+	bbs := &BollingerBandsSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{0, 1, 2, 3},
+			YValues: []float64{1, 2, 1, 2},
+		},
+		Period: 2,
+	}
+
+	Draw.BoundedSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, Style{StrokeColor: ColorBlue, StrokeWidth: 1}, bbs)
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), "L 33.33 ")
+}
+
+func TestChartRenderFloatDots(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  90,
+		Height: 90,
+		XAxis:  XAxis{Style: Hidden()},
+		YAxis:  YAxis{Style: Hidden()},
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{DotWidth: 2.5, StrokeWidth: 1, StrokeColor: ColorBlue},
+				XValues: []float64{0, 1, 2},
+				YValues: []float64{0, 1, 2},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 3, strings.Count(buffer.String(), `r="2.5"`))
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
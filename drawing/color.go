@@ -1,7 +1,9 @@
 package drawing
 
 import (
+	"math"
 	"strconv"
+	"sync"
 )
 
 var (
@@ -118,16 +120,46 @@ func (c Color) AverageWith(other Color) Color {
 	}
 }
 
-var cCache = make(map[Color]string)
+// Interpolate returns the color a given ratio of the way from the color to
+// another, where 0 is the color and 1 is the other color.
+func (c Color) Interpolate(other Color, ratio float64) Color {
+	lerp := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*ratio))
+	}
+	return Color{
+		R: lerp(c.R, other.R),
+		G: lerp(c.G, other.G),
+		B: lerp(c.B, other.B),
+		A: lerp(c.A, other.A),
+	}
+}
+
+// cCacheCapacity is the number of color strings cached before the cache is cleared.
+const cCacheCapacity = 4096
+
+var (
+	cCache      = make(map[Color]string)
+	cCacheMutex sync.RWMutex
+)
 
 // String returns a css string representation of the color.
 func (c Color) String() string {
-	if a, ok := cCache[c]; ok {
+	cCacheMutex.RLock()
+	a, ok := cCache[c]
+	cCacheMutex.RUnlock()
+	if ok {
 		return a
 	}
+
 	fa := float64(c.A) / float64(255)
 	result := "rgba(" + strconv.FormatUint(uint64(c.R), 10) + "," + strconv.FormatUint(uint64(c.G), 10) + "," + strconv.FormatUint(uint64(c.B), 10) + "," + fastFloater(fa) + ")"
+
+	cCacheMutex.Lock()
+	if len(cCache) >= cCacheCapacity {
+		cCache = make(map[Color]string)
+	}
 	cCache[c] = result
+	cCacheMutex.Unlock()
 	return result
 }
 
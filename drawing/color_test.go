@@ -51,3 +51,13 @@ func TestColorFromAlphaMixedRGBA(t *testing.T) {
 	white := ColorFromAlphaMixedRGBA(color.White.RGBA())
 	testutil.AssertTrue(t, white.Equals(ColorWhite), white.String())
 }
+
+func TestColorInterpolate(t *testing.T) {
+	// replaced new assertions helper
+
+	from := Color{R: 0, G: 100, B: 255, A: 255}
+	to := Color{R: 255, G: 0, B: 255, A: 0}
+	testutil.AssertEqual(t, from, from.Interpolate(to, 0))
+	testutil.AssertEqual(t, to, from.Interpolate(to, 1))
+	testutil.AssertEqual(t, Color{R: 128, G: 50, B: 255, A: 128}, from.Interpolate(to, 0.5))
+}
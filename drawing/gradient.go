@@ -0,0 +1,241 @@
+package drawing
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/golang/freetype/raster"
+)
+
+// GradientType is the shape of a gradient.
+type GradientType int
+
+const (
+	// GradientTypeLinear changes color along a line.
+	GradientTypeLinear GradientType = 0
+	// GradientTypeRadial changes color outwards from a center.
+	GradientTypeRadial GradientType = 1
+)
+
+// GradientStop is a color at an offset between 0 and 1 along a gradient.
+type GradientStop struct {
+	Offset float64
+	Color  Color
+}
+
+// Gradient is a fill that blends between colors.
+// Coordinates are fractions of the bounding box of the filled shape, so
+// (0, 0) is its top left and (1, 1) its bottom right.
+// Colors are padded beyond the first and last stop.
+type Gradient struct {
+	Type GradientType
+
+	// X1, Y1, X2 and Y2 are the start and end of a linear gradient.
+	X1, Y1, X2, Y2 float64
+
+	// CX, CY and R are the center and radius of a radial gradient.
+	CX, CY, R float64
+
+	Stops []GradientStop
+}
+
+// NewLinearGradient returns a gradient from (x1, y1) to (x2, y2) with colors
+// spread evenly along it.
+func NewLinearGradient(x1, y1, x2, y2 float64, colors ...Color) *Gradient {
+	return &Gradient{
+		Type:  GradientTypeLinear,
+		X1:    x1,
+		Y1:    y1,
+		X2:    x2,
+		Y2:    y2,
+		Stops: evenStops(colors),
+	}
+}
+
+// NewVerticalGradient returns a gradient from the top to the bottom of a shape.
+func NewVerticalGradient(top, bottom Color) *Gradient {
+	return NewLinearGradient(0, 0, 0, 1, top, bottom)
+}
+
+// NewHorizontalGradient returns a gradient from the left to the right of a shape.
+func NewHorizontalGradient(left, right Color) *Gradient {
+	return NewLinearGradient(0, 0, 1, 0, left, right)
+}
+
+// NewRadialGradient returns a gradient from a center out to a radius with
+// colors spread evenly along it.
+func NewRadialGradient(cx, cy, r float64, colors ...Color) *Gradient {
+	return &Gradient{
+		Type:  GradientTypeRadial,
+		CX:    cx,
+		CY:    cy,
+		R:     r,
+		Stops: evenStops(colors),
+	}
+}
+
+func evenStops(colors []Color) []GradientStop {
+	stops := make([]GradientStop, len(colors))
+	for i, c := range colors {
+		if len(colors) > 1 {
+			stops[i].Offset = float64(i) / float64(len(colors)-1)
+		}
+		stops[i].Color = c
+	}
+	return stops
+}
+
+// Offset returns the offset along the gradient of a point given as a
+// fraction of the bounding box.
+func (g Gradient) Offset(x, y float64) float64 {
+	var t float64
+	switch g.Type {
+	case GradientTypeRadial:
+		if g.R > 0 {
+			t = math.Hypot(x-g.CX, y-g.CY) / g.R
+		}
+	default:
+		dx, dy := g.X2-g.X1, g.Y2-g.Y1
+		if length := dx*dx + dy*dy; length > 0 {
+			t = ((x-g.X1)*dx + (y-g.Y1)*dy) / length
+		}
+	}
+	return math.Max(0, math.Min(1, t))
+}
+
+// ColorAt returns the color at an offset, interpolated between the
+// surrounding stops.
+func (g Gradient) ColorAt(offset float64) Color {
+	if len(g.Stops) == 0 {
+		return ColorTransparent
+	}
+	stops := g.sortedStops()
+	if offset <= stops[0].Offset {
+		return stops[0].Color
+	}
+	for i := 1; i < len(stops); i++ {
+		if offset <= stops[i].Offset {
+			from, to := stops[i-1], stops[i]
+			span := to.Offset - from.Offset
+			if span <= 0 {
+				return to.Color
+			}
+			return from.Color.Interpolate(to.Color, (offset-from.Offset)/span)
+		}
+	}
+	return stops[len(stops)-1].Color
+}
+
+// sortedStops returns the stops ordered by offset.
+func (g Gradient) sortedStops() []GradientStop {
+	if sort.SliceIsSorted(g.Stops, func(i, j int) bool { return g.Stops[i].Offset < g.Stops[j].Offset }) {
+		return g.Stops
+	}
+	stops := append([]GradientStop(nil), g.Stops...)
+	sort.SliceStable(stops, func(i, j int) bool { return stops[i].Offset < stops[j].Offset })
+	return stops
+}
+
+// GradientPainter is a Painter that fills spans of an RGBA image with a
+// gradient over a bounding box, compositing with the Over operator.
+type GradientPainter struct {
+	Image    *image.RGBA
+	Gradient Gradient
+	// Bounds is the bounding box of the filled shape in pixels.
+	Bounds [4]float64
+}
+
+// NewGradientPainter returns a painter for a gradient over a bounding box
+// given as left, top, right and bottom.
+func NewGradientPainter(img *image.RGBA, gradient Gradient, left, top, right, bottom float64) *GradientPainter {
+	return &GradientPainter{
+		Image:    img,
+		Gradient: gradient,
+		Bounds:   [4]float64{left, top, right, bottom},
+	}
+}
+
+// SetColor is ignored, as the colors come from the gradient.
+func (gp *GradientPainter) SetColor(_ color.Color) {}
+
+// Paint implements the raster.Painter interface.
+func (gp *GradientPainter) Paint(ss []raster.Span, done bool) {
+	const m = 1<<16 - 1
+	b := gp.Image.Bounds()
+	left, top := gp.Bounds[0], gp.Bounds[1]
+	width, height := gp.Bounds[2]-left, gp.Bounds[3]-top
+	gradient := gp.Gradient
+	gradient.Stops = gradient.sortedStops()
+
+	for _, s := range ss {
+		if s.Y < b.Min.Y || s.Y >= b.Max.Y {
+			continue
+		}
+		x0, x1 := s.X0, s.X1
+		if x0 < b.Min.X {
+			x0 = b.Min.X
+		}
+		if x1 > b.Max.X {
+			x1 = b.Max.X
+		}
+		if x0 >= x1 {
+			continue
+		}
+
+		var fy float64
+		if height > 0 {
+			fy = (float64(s.Y) + 0.5 - top) / height
+		}
+		i := (s.Y-b.Min.Y)*gp.Image.Stride + (x0-b.Min.X)*4
+		for x := x0; x < x1; x, i = x+1, i+4 {
+			var fx float64
+			if width > 0 {
+				fx = (float64(x) + 0.5 - left) / width
+			}
+			cr, cg, cb, ca := gradient.ColorAt(gradient.Offset(fx, fy)).RGBA()
+
+			ma := s.Alpha
+			a := (m - (ca * ma / m)) * 0x101
+			pix := gp.Image.Pix[i : i+4 : i+4]
+			pix[0] = uint8(((uint32(pix[0])*a + cr*ma) / m) >> 8)
+			pix[1] = uint8(((uint32(pix[1])*a + cg*ma) / m) >> 8)
+			pix[2] = uint8(((uint32(pix[2])*a + cb*ma) / m) >> 8)
+			pix[3] = uint8(((uint32(pix[3])*a + ca*ma) / m) >> 8)
+		}
+	}
+}
+
+// boundsFlattener records the bounding box of the points flattened into it.
+type boundsFlattener struct {
+	left, top, right, bottom float64
+	hasPoints                bool
+}
+
+func (bf *boundsFlattener) add(x, y float64) {
+	if !bf.hasPoints {
+		bf.left, bf.top, bf.right, bf.bottom = x, y, x, y
+		bf.hasPoints = true
+		return
+	}
+	bf.left = math.Min(bf.left, x)
+	bf.top = math.Min(bf.top, y)
+	bf.right = math.Max(bf.right, x)
+	bf.bottom = math.Max(bf.bottom, y)
+}
+
+// MoveTo implements the Flattener interface.
+func (bf *boundsFlattener) MoveTo(x, y float64) { bf.add(x, y) }
+
+// LineTo implements the Flattener interface.
+func (bf *boundsFlattener) LineTo(x, y float64) { bf.add(x, y) }
+
+// LineJoin implements the Flattener interface.
+func (bf *boundsFlattener) LineJoin() {}
+
+// Close implements the Flattener interface.
+func (bf *boundsFlattener) Close() {}
+
+// End implements the Flattener interface.
+func (bf *boundsFlattener) End() {}
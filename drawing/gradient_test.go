@@ -0,0 +1,81 @@
+package drawing
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestGradientColorAt(t *testing.T) {
+	// replaced new assertions helper
+
+	g := NewLinearGradient(0, 0, 1, 0, ColorBlack, ColorWhite)
+	testutil.AssertEqual(t, ColorBlack, g.ColorAt(0))
+	testutil.AssertEqual(t, ColorWhite, g.ColorAt(1))
+	testutil.AssertEqual(t, Color{R: 128, G: 128, B: 128, A: 255}, g.ColorAt(0.5))
+
+	// out of order stops are sorted.
+	g = &Gradient{Stops: []GradientStop{{Offset: 1, Color: ColorBlue}, {Offset: 0.5, Color: ColorRed}}}
+	testutil.AssertEqual(t, ColorRed, g.ColorAt(0))
+	testutil.AssertEqual(t, ColorRed, g.ColorAt(0.5))
+	testutil.AssertEqual(t, ColorBlue, g.ColorAt(1))
+
+	testutil.AssertEqual(t, ColorTransparent, Gradient{}.ColorAt(0.5))
+}
+
+func TestGradientOffset(t *testing.T) {
+	// replaced new assertions helper
+
+	vertical := NewVerticalGradient(ColorBlack, ColorWhite)
+	testutil.AssertEqual(t, 0.0, vertical.Offset(0.5, 0))
+	testutil.AssertEqual(t, 0.25, vertical.Offset(0.9, 0.25))
+	testutil.AssertEqual(t, 1.0, vertical.Offset(0.5, 2))
+	testutil.AssertEqual(t, 0.0, vertical.Offset(0.5, -1))
+
+	diagonal := NewLinearGradient(0, 0, 1, 1, ColorBlack, ColorWhite)
+	testutil.AssertInDelta(t, 0.5, diagonal.Offset(1, 0), 0.0001)
+
+	radial := NewRadialGradient(0.5, 0.5, 0.5, ColorBlack, ColorWhite)
+	testutil.AssertEqual(t, 0.0, radial.Offset(0.5, 0.5))
+	testutil.AssertEqual(t, 1.0, radial.Offset(1, 0.5))
+	testutil.AssertInDelta(t, 0.5, radial.Offset(0.5, 0.25), 0.0001)
+}
+
+func TestGradientFill(t *testing.T) {
+	// replaced new assertions helper
+
+	img := image.NewRGBA(image.Rect(0, 0, 100, 10))
+	gc, err := NewRasterGraphicContext(img)
+	testutil.AssertNil(t, err)
+
+	// gradients are an optional extension of the graphic context.
+	gf, isGradientFiller := interface{}(gc).(GradientFiller)
+	testutil.AssertTrue(t, isGradientFiller)
+
+	gf.SetFillGradient(NewHorizontalGradient(ColorBlack, ColorRed))
+	gc.MoveTo(0, 0)
+	gc.LineTo(100, 0)
+	gc.LineTo(100, 10)
+	gc.LineTo(0, 10)
+	gc.Close()
+	gc.Fill()
+
+	left := img.RGBAAt(0, 5)
+	right := img.RGBAAt(99, 5)
+	testutil.AssertTrue(t, left.R < 5, left)
+	testutil.AssertTrue(t, right.R > 250, right)
+	testutil.AssertEqual(t, uint8(255), img.RGBAAt(50, 5).A)
+	testutil.AssertInDelta(t, 128, float64(img.RGBAAt(50, 5).R), 2)
+
+	// setting a fill color replaces the gradient.
+	gc.SetFillColor(ColorBlue)
+	gc.MoveTo(0, 0)
+	gc.LineTo(100, 0)
+	gc.LineTo(100, 10)
+	gc.LineTo(0, 10)
+	gc.Close()
+	gc.Fill()
+	testutil.AssertEqual(t, color.RGBA{B: 255, A: 255}, img.RGBAAt(0, 5))
+}
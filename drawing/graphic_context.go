@@ -80,3 +80,20 @@ type GraphicContext interface {
 	// FillStroke first fills the paths and than strokes them
 	FillStroke(paths ...*Path)
 }
+
+// GradientFiller is an optional extension of GraphicContext for backends
+// that can fill paths with a gradient. Callers check for it with a type
+// assertion, so backends that don't implement it fill with the fill color.
+type GradientFiller interface {
+	// SetFillGradient sets the current fill gradient
+	SetFillGradient(g *Gradient)
+}
+
+// PatternFiller is an optional extension of GraphicContext for backends
+// that can draw a hatch pattern over the fill color. Callers check for it
+// with a type assertion, so backends that don't implement it fill with the
+// fill color only.
+type PatternFiller interface {
+	// SetFillPattern sets the current fill pattern
+	SetFillPattern(p *Pattern)
+}
@@ -0,0 +1,185 @@
+package drawing
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/golang/freetype/raster"
+)
+
+const (
+	// DefaultPatternSize is the default spacing of a pattern in pixels.
+	DefaultPatternSize = 8.0
+	// DefaultPatternLineWidth is the default width of a pattern's lines in pixels.
+	DefaultPatternLineWidth = 1.0
+)
+
+// PatternType is the shape of a hatch pattern.
+type PatternType int
+
+const (
+	// PatternTypeDiagonal is lines from the bottom left to the top right.
+	PatternTypeDiagonal PatternType = 0
+	// PatternTypeCross is horizontal and vertical lines.
+	PatternTypeCross PatternType = 1
+	// PatternTypeDots is a grid of dots.
+	PatternTypeDots PatternType = 2
+	// PatternTypeHorizontal is horizontal lines.
+	PatternTypeHorizontal PatternType = 3
+)
+
+// Pattern is a hatch fill that repeats a shape over the background.
+// Patterns are aligned to the image rather than to the filled shape, so
+// adjacent shapes with the same pattern line up.
+type Pattern struct {
+	Type PatternType
+	// Color is the color of the lines or dots, black if unset.
+	Color Color
+	// Background is the color under the pattern; if unset the fill color is used.
+	Background Color
+	// Size is the spacing of the lines or dots in pixels.
+	Size float64
+	// LineWidth is the width of the lines, or the radius of the dots, in pixels.
+	LineWidth float64
+}
+
+// NewPattern returns a pattern of a given type and color at the default size.
+func NewPattern(patternType PatternType, c Color) *Pattern {
+	return &Pattern{
+		Type:  patternType,
+		Color: c,
+	}
+}
+
+// GetColor returns the color of the lines or dots.
+func (p Pattern) GetColor() Color {
+	if p.Color.IsZero() {
+		return ColorBlack
+	}
+	return p.Color
+}
+
+// GetSize returns the spacing of the lines or dots.
+func (p Pattern) GetSize() float64 {
+	if p.Size <= 0 {
+		return DefaultPatternSize
+	}
+	return p.Size
+}
+
+// GetLineWidth returns the width of the lines or radius of the dots.
+func (p Pattern) GetLineWidth() float64 {
+	if p.LineWidth <= 0 {
+		return DefaultPatternLineWidth
+	}
+	return p.LineWidth
+}
+
+// Coverage returns how much of the pixel centered on a point is covered by
+// the pattern, from 0 to 1.
+func (p Pattern) Coverage(x, y float64) float64 {
+	size := p.GetSize()
+	width := p.GetLineWidth()
+	// the offset of the point within its tile.
+	tx, ty := positiveMod(x, size), positiveMod(y, size)
+
+	var distance float64
+	switch p.Type {
+	case PatternTypeCross:
+		distance = math.Min(math.Abs(tx-size/2), math.Abs(ty-size/2))
+	case PatternTypeDots:
+		return clampCoverage(width + 0.5 - math.Hypot(tx-size/2, ty-size/2))
+	case PatternTypeHorizontal:
+		distance = math.Abs(ty - size/2)
+	default:
+		// lines run through the tile corners, where x+y is a multiple of the size.
+		u := positiveMod(x+y, size)
+		distance = math.Min(u, size-u) / math.Sqrt2
+	}
+	return clampCoverage(width/2 + 0.5 - distance)
+}
+
+func positiveMod(v, m float64) float64 {
+	r := math.Mod(v, m)
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+func clampCoverage(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// PatternPainter is a Painter that fills spans of an RGBA image with a
+// pattern over a background color, compositing with the Over operator.
+type PatternPainter struct {
+	Image      *image.RGBA
+	Pattern    Pattern
+	Background color.Color
+}
+
+// NewPatternPainter returns a painter for a pattern, using the pattern's
+// background if it has one and a given background otherwise.
+func NewPatternPainter(img *image.RGBA, pattern Pattern, background color.Color) *PatternPainter {
+	if !pattern.Background.IsZero() {
+		background = pattern.Background
+	}
+	return &PatternPainter{
+		Image:      img,
+		Pattern:    pattern,
+		Background: background,
+	}
+}
+
+// SetColor sets the background color, as the pattern has its own color.
+func (pp *PatternPainter) SetColor(c color.Color) {
+	pp.Background = c
+}
+
+// Paint implements the raster.Painter interface.
+func (pp *PatternPainter) Paint(ss []raster.Span, done bool) {
+	const m = 1<<16 - 1
+	b := pp.Image.Bounds()
+	var br, bg, bb, ba uint32
+	if pp.Background != nil {
+		br, bg, bb, ba = pp.Background.RGBA()
+	}
+	fr, fg, fb, fa := pp.Pattern.GetColor().RGBA()
+
+	for _, s := range ss {
+		if s.Y < b.Min.Y || s.Y >= b.Max.Y {
+			continue
+		}
+		x0, x1 := s.X0, s.X1
+		if x0 < b.Min.X {
+			x0 = b.Min.X
+		}
+		if x1 > b.Max.X {
+			x1 = b.Max.X
+		}
+		if x0 >= x1 {
+			continue
+		}
+
+		i := (s.Y-b.Min.Y)*pp.Image.Stride + (x0-b.Min.X)*4
+		for x := x0; x < x1; x, i = x+1, i+4 {
+			// the pattern over the background, both premultiplied.
+			coverage := uint32(pp.Pattern.Coverage(float64(x)+0.5, float64(s.Y)+0.5) * m)
+			under := m - fa*coverage/m
+			cr := (fr*coverage + br*under) / m
+			cg := (fg*coverage + bg*under) / m
+			cb := (fb*coverage + bb*under) / m
+			ca := (fa*coverage + ba*under) / m
+
+			ma := s.Alpha
+			a := (m - (ca * ma / m)) * 0x101
+			pix := pp.Image.Pix[i : i+4 : i+4]
+			pix[0] = uint8(((uint32(pix[0])*a + cr*ma) / m) >> 8)
+			pix[1] = uint8(((uint32(pix[1])*a + cg*ma) / m) >> 8)
+			pix[2] = uint8(((uint32(pix[2])*a + cb*ma) / m) >> 8)
+			pix[3] = uint8(((uint32(pix[3])*a + ca*ma) / m) >> 8)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package drawing
+
+import (
+	"image"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestPatternDefaults(t *testing.T) {
+	// replaced new assertions helper
+
+	p := Pattern{}
+	testutil.AssertEqual(t, ColorBlack, p.GetColor())
+	testutil.AssertEqual(t, DefaultPatternSize, p.GetSize())
+	testutil.AssertEqual(t, DefaultPatternLineWidth, p.GetLineWidth())
+
+	p = *NewPattern(PatternTypeDots, ColorRed)
+	testutil.AssertEqual(t, PatternTypeDots, p.Type)
+	testutil.AssertEqual(t, ColorRed, p.GetColor())
+}
+
+func TestPatternCoverage(t *testing.T) {
+	// replaced new assertions helper
+
+	horizontal := Pattern{Type: PatternTypeHorizontal, Size: 10, LineWidth: 2}
+	testutil.AssertEqual(t, 1.0, horizontal.Coverage(3, 5))
+	testutil.AssertEqual(t, 1.0, horizontal.Coverage(3, 25))
+	testutil.AssertEqual(t, 1.0, horizontal.Coverage(-7, -5))
+	testutil.AssertEqual(t, 0.0, horizontal.Coverage(3, 0))
+	testutil.AssertEqual(t, 0.5, horizontal.Coverage(3, 6))
+
+	cross := Pattern{Type: PatternTypeCross, Size: 10, LineWidth: 2}
+	testutil.AssertEqual(t, 1.0, cross.Coverage(5, 1))
+	testutil.AssertEqual(t, 1.0, cross.Coverage(1, 5))
+	testutil.AssertEqual(t, 0.0, cross.Coverage(1, 1))
+
+	dots := Pattern{Type: PatternTypeDots, Size: 10, LineWidth: 2}
+	testutil.AssertEqual(t, 1.0, dots.Coverage(5, 5))
+	testutil.AssertEqual(t, 0.0, dots.Coverage(5, 1))
+	testutil.AssertEqual(t, 0.0, dots.Coverage(1, 5))
+
+	diagonal := Pattern{Type: PatternTypeDiagonal, Size: 10, LineWidth: 2}
+	testutil.AssertEqual(t, 1.0, diagonal.Coverage(0, 10))
+	testutil.AssertEqual(t, 1.0, diagonal.Coverage(3, 7))
+	testutil.AssertEqual(t, 1.0, diagonal.Coverage(13, 7))
+	testutil.AssertEqual(t, 0.0, diagonal.Coverage(5, 0))
+}
+
+func TestPatternFill(t *testing.T) {
+	// replaced new assertions helper
+
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	gc, err := NewRasterGraphicContext(img)
+	testutil.AssertNil(t, err)
+
+	// patterns are an optional extension of the graphic context.
+	pf, isPatternFiller := interface{}(gc).(PatternFiller)
+	testutil.AssertTrue(t, isPatternFiller)
+
+	gc.SetFillColor(ColorWhite)
+	pf.SetFillPattern(&Pattern{Type: PatternTypeHorizontal, Color: ColorBlue, Size: 8, LineWidth: 2})
+	gc.MoveTo(0, 0)
+	gc.LineTo(16, 0)
+	gc.LineTo(16, 16)
+	gc.LineTo(0, 16)
+	gc.Close()
+	gc.Fill()
+
+	// the lines are at the middle of each tile, over the fill color.
+	testutil.AssertEqual(t, ColorBlue, ColorFromAlphaMixedRGBA(img.At(3, 4).RGBA()))
+	testutil.AssertEqual(t, ColorBlue, ColorFromAlphaMixedRGBA(img.At(3, 12).RGBA()))
+	testutil.AssertEqual(t, ColorWhite, ColorFromAlphaMixedRGBA(img.At(3, 0).RGBA()))
+	testutil.AssertEqual(t, ColorWhite, ColorFromAlphaMixedRGBA(img.At(3, 8).RGBA()))
+}
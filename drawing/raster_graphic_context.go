@@ -219,6 +219,28 @@ func (rgc *RasterGraphicContext) paint(rasterizer *raster.Rasterizer, color colo
 	rgc.current.Path.Clear()
 }
 
+// paintFill fills the rasterized paths with the fill pattern if one is set,
+// the fill gradient over their bounds if one is set, or with the fill color
+// otherwise.
+func (rgc *RasterGraphicContext) paintFill(rasterizer *raster.Rasterizer, bounds *boundsFlattener) {
+	img, isRGBA := rgc.img.(*image.RGBA)
+	var painter raster.Painter
+	switch {
+	case !isRGBA:
+	case rgc.current.FillPattern != nil:
+		painter = NewPatternPainter(img, *rgc.current.FillPattern, rgc.current.FillColor)
+	case rgc.current.FillGradient != nil && bounds.hasPoints:
+		painter = NewGradientPainter(img, *rgc.current.FillGradient, bounds.left, bounds.top, bounds.right, bounds.bottom)
+	}
+	if painter == nil {
+		rgc.paint(rasterizer, rgc.current.FillColor)
+		return
+	}
+	rasterizer.Rasterize(painter)
+	rasterizer.Clear()
+	rgc.current.Path.Clear()
+}
+
 // Stroke strokes the paths with the color specified by SetStrokeColor
 func (rgc *RasterGraphicContext) Stroke(paths ...*Path) {
 	paths = append(paths, rgc.current.Path)
@@ -240,17 +262,19 @@ func (rgc *RasterGraphicContext) Stroke(paths ...*Path) {
 	rgc.paint(rgc.strokeRasterizer, rgc.current.StrokeColor)
 }
 
-// Fill fills the paths with the color specified by SetFillColor
+// Fill fills the paths with the pattern specified by SetFillPattern, the
+// gradient specified by SetFillGradient, or the color specified by SetFillColor
 func (rgc *RasterGraphicContext) Fill(paths ...*Path) {
 	paths = append(paths, rgc.current.Path)
 	rgc.fillRasterizer.UseNonZeroWinding = rgc.current.FillRule == FillRuleWinding
 
-	flattener := Transformer{Tr: rgc.current.Tr, Flattener: FtLineBuilder{Adder: rgc.fillRasterizer}}
+	bounds := &boundsFlattener{}
+	flattener := Transformer{Tr: rgc.current.Tr, Flattener: DemuxFlattener{Flatteners: []Flattener{FtLineBuilder{Adder: rgc.fillRasterizer}, bounds}}}
 	for _, p := range paths {
 		Flatten(p, flattener, rgc.current.Tr.GetScale())
 	}
 
-	rgc.paint(rgc.fillRasterizer, rgc.current.FillColor)
+	rgc.paintFill(rgc.fillRasterizer, bounds)
 }
 
 // FillStroke first fills the paths and than strokes them
@@ -259,7 +283,8 @@ func (rgc *RasterGraphicContext) FillStroke(paths ...*Path) {
 	rgc.fillRasterizer.UseNonZeroWinding = rgc.current.FillRule == FillRuleWinding
 	rgc.strokeRasterizer.UseNonZeroWinding = true
 
-	flattener := Transformer{Tr: rgc.current.Tr, Flattener: FtLineBuilder{Adder: rgc.fillRasterizer}}
+	bounds := &boundsFlattener{}
+	flattener := Transformer{Tr: rgc.current.Tr, Flattener: DemuxFlattener{Flatteners: []Flattener{FtLineBuilder{Adder: rgc.fillRasterizer}, bounds}}}
 
 	stroker := NewLineStroker(rgc.current.Cap, rgc.current.Join, Transformer{Tr: rgc.current.Tr, Flattener: FtLineBuilder{Adder: rgc.strokeRasterizer}})
 	stroker.HalfLineWidth = rgc.current.LineWidth / 2
@@ -277,7 +302,7 @@ func (rgc *RasterGraphicContext) FillStroke(paths ...*Path) {
 	}
 
 	// Fill
-	rgc.paint(rgc.fillRasterizer, rgc.current.FillColor)
+	rgc.paintFill(rgc.fillRasterizer, bounds)
 	// Stroke
 	rgc.paint(rgc.strokeRasterizer, rgc.current.StrokeColor)
 }
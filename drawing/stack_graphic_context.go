@@ -25,6 +25,11 @@ type ContextStack struct {
 	Cap         LineCap
 	Join        LineJoin
 
+	// FillGradient is used instead of the fill color if set.
+	FillGradient *Gradient
+	// FillPattern is drawn over the fill color if set.
+	FillPattern *Pattern
+
 	FontSizePoints float64
 	Font           *truetype.Font
 
@@ -84,9 +89,21 @@ func (gc *StackGraphicContext) SetStrokeColor(c color.Color) {
 	gc.current.StrokeColor = c
 }
 
-// SetFillColor sets the fill color.
+// SetFillColor sets the fill color, replacing any fill gradient or pattern.
 func (gc *StackGraphicContext) SetFillColor(c color.Color) {
 	gc.current.FillColor = c
+	gc.current.FillGradient = nil
+	gc.current.FillPattern = nil
+}
+
+// SetFillGradient sets a gradient to fill with instead of the fill color.
+func (gc *StackGraphicContext) SetFillGradient(g *Gradient) {
+	gc.current.FillGradient = g
+}
+
+// SetFillPattern sets a pattern to draw over the fill color.
+func (gc *StackGraphicContext) SetFillPattern(p *Pattern) {
+	gc.current.FillPattern = p
 }
 
 // SetFillRule sets the fill rule.
@@ -183,6 +200,8 @@ func (gc *StackGraphicContext) Save() {
 	context.LineWidth = gc.current.LineWidth
 	context.StrokeColor = gc.current.StrokeColor
 	context.FillColor = gc.current.FillColor
+	context.FillGradient = gc.current.FillGradient
+	context.FillPattern = gc.current.FillPattern
 	context.FillRule = gc.current.FillRule
 	context.Dash = gc.current.Dash
 	context.DashOffset = gc.current.DashOffset
@@ -0,0 +1,19 @@
+package chart
+
+import "github.com/userstyles-world/go-chart/v2/drawing"
+
+// GradientRenderer is an optional extension of Renderer for renderers that
+// can fill shapes with a gradient instead of a solid color.
+// Charts check for it with a type assertion, so renderers that don't
+// implement it fill with the fill color instead.
+type GradientRenderer interface {
+	// SetFillGradient sets a gradient to fill with until the fill color is next set.
+	SetFillGradient(gradient *drawing.Gradient)
+}
+
+// setFillGradient sets the fill gradient if the renderer supports it.
+func setFillGradient(r Renderer, gradient *drawing.Gradient) {
+	if gr, isGradientRenderer := unwrapRenderer(r).(GradientRenderer); isGradientRenderer {
+		gr.SetFillGradient(gradient)
+	}
+}
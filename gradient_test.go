@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/drawing"
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestStyleFillGradient(t *testing.T) {
+	// replaced new assertions helper
+
+	gradient := drawing.NewVerticalGradient(ColorBlue, ColorWhite)
+	set := Style{FillGradient: gradient}
+	testutil.AssertTrue(t, set.ShouldDrawFill())
+	testutil.AssertEqual(t, gradient, set.GetFillOptions().FillGradient)
+	testutil.AssertEqual(t, gradient, set.GetFillAndStrokeOptions().FillGradient)
+	testutil.AssertNil(t, set.GetStrokeOptions().FillGradient)
+	testutil.AssertEqual(t, gradient, Style{}.InheritFrom(set).FillGradient)
+	testutil.AssertNil(t, Style{}.GetFillGradient())
+}
+
+func TestVectorRendererGradient(t *testing.T) {
+	// replaced new assertions helper
+
+	gradient := drawing.NewVerticalGradient(ColorBlue, ColorWhite)
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.Box(r, Box{Top: 10, Left: 10, Right: 40, Bottom: 40}, Style{FillGradient: gradient})
+	Draw.Box(r, Box{Top: 50, Left: 50, Right: 90, Bottom: 90}, Style{FillGradient: drawing.NewVerticalGradient(ColorBlue, ColorWhite)})
+	Draw.Box(r, Box{Top: 10, Left: 50, Right: 90, Bottom: 40}, Style{FillGradient: drawing.NewRadialGradient(0.5, 0.5, 0.5, ColorRed, ColorBlue)})
+	Draw.Box(r, Box{Top: 50, Left: 10, Right: 40, Bottom: 90}, Style{FillColor: ColorRed})
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	svg := buffer.String()
+	assertWellFormedXML(t, svg)
+
+	id, _ := gradientDefinition(gradient)
+	// equal gradients are only defined once.
+	testutil.AssertEqual(t, 1, strings.Count(svg, `<linearGradient id="`+id+`" x1="0" y1="0" x2="0" y2="1"><stop offset="0" stop-color="rgba(0,116,217,1)"/><stop offset="1" stop-color="rgba(255,255,255,1)"/></linearGradient>`))
+	testutil.AssertEqual(t, 2, strings.Count(svg, "fill:url(#"+id+")"))
+	testutil.AssertContains(t, svg, `<radialGradient id="gradient-`)
+	testutil.AssertContains(t, svg, `cx="0.5" cy="0.5" r="0.5"`)
+	// a fill color replaces the gradient.
+	testutil.AssertContains(t, svg, "fill:"+ColorRed.String())
+
+	// class names keep the gradient as a presentation attribute.
+	r, err = SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.Box(r, Box{Top: 10, Left: 10, Right: 40, Bottom: 40}, Style{ClassName: "bar", FillGradient: gradient})
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `class="bar fill" fill="url(#`+id+`)"`)
+}
+
+func TestRasterRendererGradient(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.Box(r, Box{Top: 0, Left: 0, Right: 100, Bottom: 100}, Style{FillGradient: drawing.NewVerticalGradient(drawing.ColorBlack, drawing.ColorWhite)})
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	img, err := png.Decode(buffer)
+	testutil.AssertNil(t, err)
+
+	top, _, _, _ := img.At(50, 2).RGBA()
+	bottom, _, _, _ := img.At(50, 97).RGBA()
+	testutil.AssertTrue(t, top>>8 < 10, top>>8)
+	testutil.AssertTrue(t, bottom>>8 > 245, bottom>>8)
+}
+
+func TestChartGradientFills(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Background: Style{FillGradient: drawing.NewRadialGradient(0.5, 0.5, 0.75, ColorWhite, ColorLightGray)},
+		Series: []Series{
+			ContinuousSeries{
+				Style: Style{
+					StrokeColor:  ColorBlue,
+					StrokeWidth:  1,
+					FillGradient: drawing.NewVerticalGradient(ColorBlue, ColorBlue.WithAlpha(0)),
+				},
+				XValues: []float64{0, 1, 2},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 1, strings.Count(buffer.String(), "<radialGradient"))
+	testutil.AssertEqual(t, 1, strings.Count(buffer.String(), "<linearGradient"))
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), "fill:url(#gradient-"))
+
+	bc := BarChart{
+		Bars: []Value{
+			{Value: 1, Label: "a", Style: Style{FillGradient: drawing.NewHorizontalGradient(ColorRed, ColorOrange)}},
+			{Value: 2, Label: "b"},
+		},
+	}
+	buffer.Reset()
+	testutil.AssertNil(t, bc.Render(SVG, buffer))
+	testutil.AssertEqual(t, 1, strings.Count(buffer.String(), "fill:url(#gradient-"))
+
+	buffer.Reset()
+	testutil.AssertNil(t, bc.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
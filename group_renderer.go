@@ -0,0 +1,76 @@
+package chart
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultGroupIDPrefix is the default prefix of the ids of a chart's element groups.
+const DefaultGroupIDPrefix = "chart"
+
+// GroupRenderer is an optional extension of Renderer for output formats that
+// can structure the drawing into nested, addressable groups, such as SVG.
+// Charts check for it with a type assertion, so renderers that don't
+// implement it are unaffected.
+type GroupRenderer interface {
+	// BeginGroup starts a group; everything drawn until the matching EndGroup belongs to it.
+	BeginGroup(group Group)
+
+	// EndGroup ends the group started by the last unmatched BeginGroup.
+	EndGroup()
+}
+
+// Group identifies a group of drawing commands.
+type Group struct {
+	// ID is unique within the document.
+	ID string
+	// ClassName is a space separated list of class names.
+	ClassName string
+	// Label is an accessible label for the group, if any.
+	Label string
+}
+
+// beginGroup starts a group if the renderer supports it.
+func beginGroup(r Renderer, group Group) {
+	if gr, isGroupRenderer := unwrapRenderer(r).(GroupRenderer); isGroupRenderer {
+		gr.BeginGroup(group)
+	}
+}
+
+// endGroup ends a group if the renderer supports it.
+func endGroup(r Renderer) {
+	if gr, isGroupRenderer := unwrapRenderer(r).(GroupRenderer); isGroupRenderer {
+		gr.EndGroup()
+	}
+}
+
+// joinClassNames joins the non-empty class names with spaces.
+func joinClassNames(classNames ...string) string {
+	var output []string
+	for _, className := range classNames {
+		if len(className) > 0 {
+			output = append(output, className)
+		}
+	}
+	return strings.Join(output, " ")
+}
+
+// slugify returns a lower case version of a name usable in ids and class
+// names, with every run of other characters than letters and digits replaced
+// by a single dash.
+func slugify(name string) string {
+	var output strings.Builder
+	var dash bool
+	for _, c := range strings.ToLower(name) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			if dash && output.Len() > 0 {
+				output.WriteRune('-')
+			}
+			output.WriteRune(c)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return output.String()
+}
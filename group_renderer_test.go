@@ -0,0 +1,101 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestSlugify(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, "", slugify(""))
+	testutil.AssertEqual(t, "", slugify(" -- "))
+	testutil.AssertEqual(t, "foo-bar", slugify("Foo Bar"))
+	testutil.AssertEqual(t, "foo-bar", slugify("  Foo & <Bar>! "))
+	testutil.AssertEqual(t, "série-2", slugify("Série #2"))
+}
+
+func TestChartGetSeriesIDs(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "Foo"},
+			ContinuousSeries{},
+			ContinuousSeries{Name: "foo"},
+			ContinuousSeries{Name: "Series 4"},
+			ContinuousSeries{Name: "4"},
+		},
+	}
+	testutil.AssertEqual(t, []string{
+		"series-foo",
+		"series-2",
+		"series-3",
+		"series-series-4",
+		"series-4",
+	}, c.getSeriesIDs())
+
+	c.Series = append(c.Series, ContinuousSeries{Name: "3"})
+	ids := c.getSeriesIDs()
+	testutil.AssertEqual(t, "series-6", ids[5])
+
+	c.Series = []Series{
+		ContinuousSeries{Name: "2"},
+		ContinuousSeries{},
+	}
+	testutil.AssertEqual(t, []string{"series-2", "series-2-2"}, c.getSeriesIDs())
+}
+
+func TestChartSVGGroups(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		ID:    "my-chart",
+		Title: "Test",
+		YAxis: YAxis{
+			Style: Style{ClassName: "values"},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "Foo Bar",
+				Style:   Style{ClassName: "primary"},
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{3, 2, 1},
+			},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertEqual(t, strings.Count(raw, "<g "), strings.Count(raw, "</g>"))
+	for _, group := range []string{
+		`<g id="my-chart-background" class="background">`,
+		`<g id="my-chart-canvas" class="canvas">`,
+		`<g id="my-chart-x-axis" class="axis x-axis">`,
+		`<g id="my-chart-y-axis" class="axis y-axis values">`,
+		`<g id="my-chart-y-axis-secondary" class="axis y-axis-secondary">`,
+		`<g id="my-chart-series-foo-bar" class="series primary" role="group" aria-label="Foo Bar">`,
+		`<g id="my-chart-series-2" class="series" role="group" aria-label="Series 2">`,
+		`<g id="my-chart-title" class="title">`,
+		`<g id="my-chart-element-1" class="element">`,
+	} {
+		testutil.AssertContains(t, raw, group)
+	}
+
+	// the raster renderer ignores groups.
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
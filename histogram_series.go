@@ -0,0 +1,256 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*HistogramSeries)(nil)
+	_ ValuesProvider      = (*HistogramSeries)(nil)
+	_ ValueBoundsProvider = (*HistogramSeries)(nil)
+
+	_ valuesSnapshotProvider = (*HistogramSeries)(nil)
+)
+
+// HistogramBinning is an enum for how a histogram divides its samples into bins.
+type HistogramBinning int
+
+const (
+	// HistogramBinningUnset is the unset state for binning, which uses a fixed
+	// count if BinCount is set, a fixed width if BinWidth is set, and Sturges'
+	// rule otherwise.
+	HistogramBinningUnset HistogramBinning = 0
+	// HistogramBinningFixedCount divides the samples into BinCount bins.
+	HistogramBinningFixedCount HistogramBinning = 1
+	// HistogramBinningFixedWidth divides the samples into bins BinWidth wide,
+	// aligned to multiples of the width.
+	HistogramBinningFixedWidth HistogramBinning = 2
+	// HistogramBinningSturges uses log2(n)+1 bins, which suits samples that
+	// are roughly normally distributed.
+	HistogramBinningSturges HistogramBinning = 3
+	// HistogramBinningFreedmanDiaconis uses bins of width 2*IQR/cbrt(n), which
+	// is robust to outliers.
+	HistogramBinningFreedmanDiaconis HistogramBinning = 4
+)
+
+// HistogramBin is a bin of a histogram from Start up to End; the last bin
+// also includes its End.
+type HistogramBin struct {
+	Start, End float64
+	Count      int
+	// Value is the height of the bar, the count or the density.
+	Value float64
+}
+
+// HistogramSeries bins raw samples and draws them as a histogram.
+//
+// The samples are Values, or the y values of InnerSeries if Values is empty.
+// NaN and infinite samples are skipped. The bins are computed on each call,
+// so call Bins once for repeated access; rendering computes them once.
+type HistogramSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Values      []float64
+	InnerSeries ValuesProvider
+
+	Binning  HistogramBinning
+	BinCount int
+	BinWidth float64
+
+	// Density scales the bars so their areas sum to one.
+	Density bool
+}
+
+// GetName returns the name of the series.
+func (hs HistogramSeries) GetName() string {
+	return hs.Name
+}
+
+// GetStyle returns the series style.
+func (hs HistogramSeries) GetStyle() Style {
+	return hs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (hs HistogramSeries) GetYAxis() YAxisType {
+	return hs.YAxis
+}
+
+// GetBinning returns the binning strategy, resolving the unset state.
+func (hs HistogramSeries) GetBinning() HistogramBinning {
+	if hs.Binning != HistogramBinningUnset {
+		return hs.Binning
+	}
+	if hs.BinCount > 0 {
+		return HistogramBinningFixedCount
+	}
+	if hs.BinWidth > 0 {
+		return HistogramBinningFixedWidth
+	}
+	return HistogramBinningSturges
+}
+
+// Len returns the number of bins.
+func (hs HistogramSeries) Len() int {
+	return len(hs.Bins())
+}
+
+// GetValues gets the center and height of the bin at a given index.
+func (hs HistogramSeries) GetValues(index int) (x, y float64) {
+	return histogramBins(hs.Bins()).GetValues(index)
+}
+
+// GetValueBounds returns the bounds of the bars, from the start of the
+// first bin to the end of the last and from 0 to the tallest bar.
+func (hs HistogramSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	bins := hs.Bins()
+	if len(bins) == 0 {
+		return
+	}
+	minx, maxx = bins[0].Start, bins[len(bins)-1].End
+	for _, bin := range bins {
+		maxy = math.Max(maxy, bin.Value)
+	}
+	return
+}
+
+// Bins returns the bins of the samples, in order.
+func (hs HistogramSeries) Bins() []HistogramBin {
+	samples := hs.samples()
+	if len(samples) == 0 {
+		return nil
+	}
+
+	start, width, count := hs.binLayout(ValueSequence(samples...))
+	bins := make([]HistogramBin, count)
+	for index := range bins {
+		bins[index].Start = start + float64(index)*width
+		bins[index].End = start + float64(index+1)*width
+	}
+	for _, v := range samples {
+		index := MinInt(MaxInt(int(math.Floor((v-start)/width)), 0), count-1)
+		bins[index].Count++
+	}
+
+	total := float64(len(samples))
+	for index := range bins {
+		if hs.Density {
+			bins[index].Value = float64(bins[index].Count) / (total * width)
+		} else {
+			bins[index].Value = float64(bins[index].Count)
+		}
+	}
+	return bins
+}
+
+// getValuesSnapshot returns the bins computed once, for the data table.
+func (hs HistogramSeries) getValuesSnapshot() ValuesProvider {
+	return histogramBins(hs.Bins())
+}
+
+// samples returns the finite samples to bin.
+func (hs HistogramSeries) samples() []float64 {
+	var samples []float64
+	add := func(v float64) {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			samples = append(samples, v)
+		}
+	}
+	if len(hs.Values) > 0 {
+		for _, v := range hs.Values {
+			add(v)
+		}
+	} else if hs.InnerSeries != nil {
+		for index := 0; index < hs.InnerSeries.Len(); index++ {
+			_, v := hs.InnerSeries.GetValues(index)
+			add(v)
+		}
+	}
+	return samples
+}
+
+// binLayout returns the start and width of the first bin and the number of bins.
+func (hs HistogramSeries) binLayout(samples Seq) (start, width float64, count int) {
+	min, max := samples.MinMax()
+	span := max - min
+	n := float64(samples.Len())
+
+	switch hs.GetBinning() {
+	case HistogramBinningFixedWidth:
+		if hs.BinWidth > 0 {
+			width = hs.BinWidth
+			start = math.Floor(min/width) * width
+			count = MaxInt(int(math.Ceil((max-start)/width)), 1)
+			if count > DefaultHistogramMaxBinCount {
+				count = DefaultHistogramMaxBinCount
+				width = (max - start) / float64(count)
+			}
+			return
+		}
+	case HistogramBinningFixedCount:
+		count = hs.BinCount
+	case HistogramBinningFreedmanDiaconis:
+		iqr := samples.Percentile(0.75) - samples.Percentile(0.25)
+		if iqr > 0 {
+			count = int(math.Ceil(span / (2 * iqr / math.Cbrt(n))))
+		}
+	}
+	if count <= 0 {
+		// Sturges' rule, which the other strategies fall back to.
+		count = int(math.Ceil(math.Log2(n))) + 1
+	}
+	count = MinInt(count, DefaultHistogramMaxBinCount)
+
+	if span == 0 {
+		// a single bin centered on the samples.
+		return min - 0.5, 1, 1
+	}
+	return min, span / float64(count), count
+}
+
+// Render renders the series.
+func (hs HistogramSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	bins := hs.Bins()
+	if len(bins) == 0 {
+		return
+	}
+
+	style := hs.Style.InheritFrom(defaults)
+	if style.FillColor.IsZero() {
+		style.FillColor = style.StrokeColor
+	}
+
+	// the bars span their bins as the range maps them.
+	barsWidth := xrange.Translate(bins[len(bins)-1].End) - xrange.Translate(bins[0].Start)
+	barWidth := MaxInt(int(math.Round(float64(barsWidth)/float64(len(bins)))), 1)
+	Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, histogramBins(bins), barWidth)
+}
+
+// Validate validates the series.
+func (hs HistogramSeries) Validate() error {
+	if len(hs.Values) == 0 && hs.InnerSeries == nil {
+		return fmt.Errorf("histogram series requires values or an inner series to be set")
+	}
+	if hs.Binning == HistogramBinningFixedCount && hs.BinCount <= 0 {
+		return fmt.Errorf("histogram series requires a positive bin count for fixed count binning")
+	}
+	if hs.Binning == HistogramBinningFixedWidth && hs.BinWidth <= 0 {
+		return fmt.Errorf("histogram series requires a positive bin width for fixed width binning")
+	}
+	return nil
+}
+
+// histogramBins provides the center and height of each bin.
+type histogramBins []HistogramBin
+
+func (hb histogramBins) Len() int {
+	return len(hb)
+}
+
+func (hb histogramBins) GetValues(index int) (x, y float64) {
+	return (hb[index].Start + hb[index].End) / 2, hb[index].Value
+}
@@ -0,0 +1,209 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestHistogramSeriesFixedWidth(t *testing.T) {
+	// replaced new assertions helper
+
+	hs := HistogramSeries{
+		Values:   []float64{1, 2, 2, 3, 3, 3, 4, 4, 5, math.NaN(), math.Inf(1)},
+		BinWidth: 1,
+	}
+	testutil.AssertEqual(t, HistogramBinningFixedWidth, hs.GetBinning())
+
+	bins := hs.Bins()
+	testutil.AssertLen(t, bins, 4)
+	testutil.AssertEqual(t, HistogramBin{Start: 1, End: 2, Count: 1, Value: 1}, bins[0])
+	// the last bin includes its end.
+	testutil.AssertEqual(t, HistogramBin{Start: 4, End: 5, Count: 3, Value: 3}, bins[3])
+
+	// bins are aligned to multiples of the width.
+	hs.BinWidth = 2
+	bins = hs.Bins()
+	testutil.AssertLen(t, bins, 3)
+	testutil.AssertEqual(t, 0.0, bins[0].Start)
+	testutil.AssertEqual(t, 6.0, bins[2].End)
+	testutil.AssertEqual(t, 1, bins[0].Count)
+	testutil.AssertEqual(t, 5, bins[1].Count)
+	testutil.AssertEqual(t, 3, bins[2].Count)
+}
+
+func TestHistogramSeriesBinCounts(t *testing.T) {
+	// replaced new assertions helper
+
+	values := make([]float64, 100)
+	for index := range values {
+		values[index] = float64(index)
+	}
+
+	testutil.AssertEqual(t, HistogramBinningSturges, HistogramSeries{}.GetBinning())
+	testutil.AssertLen(t, HistogramSeries{Values: values}.Bins(), 8)
+	testutil.AssertLen(t, HistogramSeries{Values: values, BinCount: 5}.Bins(), 5)
+
+	// an outlier widens the sturges bins, but not the freedman-diaconis ones.
+	values = append(values, 1000)
+	fd := HistogramSeries{Values: values, Binning: HistogramBinningFreedmanDiaconis}.Bins()
+	testutil.AssertLen(t, fd, 47)
+	testutil.AssertInDelta(t, 21.28, fd[0].End-fd[0].Start, 0.01)
+
+	// a zero interquartile range falls back to sturges.
+	testutil.AssertLen(t, HistogramSeries{Values: []float64{1, 1, 1, 1, 1, 1, 5}, Binning: HistogramBinningFreedmanDiaconis}.Bins(), 4)
+
+	// identical samples make a single bin around them.
+	bins := HistogramSeries{Values: []float64{3, 3, 3}}.Bins()
+	testutil.AssertLen(t, bins, 1)
+	testutil.AssertEqual(t, HistogramBin{Start: 2.5, End: 3.5, Count: 3, Value: 3}, bins[0])
+
+	testutil.AssertLen(t, HistogramSeries{Values: values, BinCount: 1 << 20}.Bins(), DefaultHistogramMaxBinCount)
+	testutil.AssertEmpty(t, HistogramSeries{Values: []float64{math.NaN()}}.Bins())
+}
+
+func TestHistogramSeriesDensity(t *testing.T) {
+	// replaced new assertions helper
+
+	hs := HistogramSeries{
+		Values:   []float64{0.1, 0.2, 0.3, 1.1, 2.5, 3.9},
+		BinWidth: 0.5,
+		Density:  true,
+	}
+
+	var area float64
+	for _, bin := range hs.Bins() {
+		area += bin.Value * (bin.End - bin.Start)
+	}
+	testutil.AssertInDelta(t, 1.0, area, 0.000001)
+	testutil.AssertInDelta(t, 1.0, hs.Bins()[0].Value, 0.000001)
+}
+
+func TestHistogramSeriesInnerSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	hs := HistogramSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 2, 3, 4},
+			YValues: []float64{10, 10, 20, 30},
+		},
+		BinCount: 2,
+	}
+	testutil.AssertEqual(t, 2, hs.Len())
+
+	x, y := hs.GetValues(0)
+	testutil.AssertEqual(t, 15.0, x)
+	testutil.AssertEqual(t, 2.0, y)
+
+	minx, maxx, miny, maxy := hs.GetValueBounds()
+	testutil.AssertEqual(t, 10.0, minx)
+	testutil.AssertEqual(t, 30.0, maxx)
+	testutil.AssertEqual(t, 0.0, miny)
+	testutil.AssertEqual(t, 2.0, maxy)
+}
+
+func TestHistogramSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	hs := HistogramSeries{
+		Values:   []float64{1, 2, 2, 3, 3, 3, 4, 4, 5},
+		BinWidth: 1,
+	}
+	testutil.AssertNil(t, hs.Validate())
+
+	c := Chart{
+		Width:  300,
+		Height: 200,
+		Series: []Series{hs},
+	}
+
+	// the ranges cover the bin edges and start from 0.
+	xrange, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 1.0, xrange.GetMin())
+	testutil.AssertEqual(t, 5.0, xrange.GetMax())
+	testutil.AssertEqual(t, 0.0, yrange.GetMin())
+	testutil.AssertEqual(t, 3.0, yrange.GetMax())
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	// the bars are as wide as their bins, and meet.
+	testutil.AssertContains(t, buffer.String(), `d="M 19 173L 79 173L 79 119L 19 119L 19 173"`)
+	testutil.AssertContains(t, buffer.String(), `d="M 79 173L 139 173L 139 65L 79 65L 79 173"`)
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+// countingValuesProvider counts the values read from it.
+type countingValuesProvider struct {
+	ContinuousSeries
+	reads *int
+}
+
+func (cvp countingValuesProvider) GetValues(index int) (x, y float64) {
+	*cvp.reads++
+	return cvp.ContinuousSeries.GetValues(index)
+}
+
+func TestHistogramSeriesRenderReads(t *testing.T) {
+	// replaced new assertions helper
+
+	render := func(samples int) int {
+		var reads int
+		values := LinearRange(1, float64(samples))
+		c := Chart{
+			Width:  300,
+			Height: 200,
+			Series: []Series{HistogramSeries{
+				InnerSeries: countingValuesProvider{
+					ContinuousSeries: ContinuousSeries{XValues: values, YValues: values},
+					reads:            &reads,
+				},
+			}},
+			DataTable: true,
+		}
+		testutil.AssertNil(t, c.Render(SVG, bytes.NewBuffer([]byte{})))
+		return reads
+	}
+
+	// the samples are binned a fixed number of times per render, not per bin.
+	testutil.AssertEqual(t, 2*render(64), render(128))
+}
+
+func TestHistogramSeriesValuesChanged(t *testing.T) {
+	// replaced new assertions helper
+
+	hs := HistogramSeries{Values: []float64{1, 2, 3, 4}, BinCount: 2}
+	testutil.AssertEqual(t, 2, hs.Len())
+
+	hs.BinCount = 4
+	testutil.AssertEqual(t, 4, hs.Len())
+	hs.Values = []float64{1, 1, 1}
+	testutil.AssertEqual(t, 1, hs.Len())
+}
+
+func TestHistogramSeriesRenderConcurrent(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:     testConcurrentWidth,
+		Height:    testConcurrentHeight,
+		Series:    []Series{HistogramSeries{Values: LinearRange(1, 100)}},
+		DataTable: true,
+	}
+	testRenderConcurrently(t, func(_ int, rp RendererProvider, buffer *bytes.Buffer) error {
+		return c.Render(rp, buffer)
+	})
+}
+
+func TestHistogramSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, HistogramSeries{}.Validate())
+	testutil.AssertNotNil(t, HistogramSeries{Values: []float64{1}, Binning: HistogramBinningFixedCount}.Validate())
+	testutil.AssertNotNil(t, HistogramSeries{Values: []float64{1}, Binning: HistogramBinningFixedWidth}.Validate())
+	testutil.AssertNil(t, HistogramSeries{Values: []float64{1}}.Validate())
+}
@@ -0,0 +1,83 @@
+package chart
+
+// DefaultHitTargetRadius is the minimum radius of the hit target around a data point.
+const DefaultHitTargetRadius = 5.0
+
+// HitTargetRenderer is an optional extension of Renderer for interactive
+// output formats, such as SVG, that can mark areas of the image as data
+// points with a tooltip.
+// Charts check for it with a type assertion, so renderers that don't
+// implement it are unaffected.
+type HitTargetRenderer interface {
+	// HitTargetsEnabled returns if hit targets are drawn; values are only
+	// formatted for them if it returns true.
+	HitTargetsEnabled() bool
+
+	// HitTarget draws an invisible target over a data point.
+	HitTarget(target HitTarget)
+}
+
+// HitTarget is the area of the image covered by a data point.
+type HitTarget struct {
+	// Box is the area of the target.
+	Box Box
+	// Round draws the target as the circle inscribed in Box.
+	Round bool
+
+	// XValue and YValue are the formatted values of the data point.
+	XValue string
+	YValue string
+
+	// Tooltip is the text shown on hover.
+	Tooltip string
+}
+
+// getHitTargetRenderer returns the renderer as a HitTargetRenderer if it has
+// hit targets enabled.
+func getHitTargetRenderer(r Renderer) (HitTargetRenderer, bool) {
+	if htr, isHitTargetRenderer := r.(HitTargetRenderer); isHitTargetRenderer && htr.HitTargetsEnabled() {
+		return htr, true
+	}
+	return nil, false
+}
+
+// hitTargetFormatter formats the hit targets of a values provider with its
+// value formatters and name, if it provides them.
+type hitTargetFormatter struct {
+	name   string
+	xf, yf ValueFormatter
+}
+
+func newHitTargetFormatter(vs ValuesProvider) hitTargetFormatter {
+	htf := hitTargetFormatter{
+		xf: FloatValueFormatter,
+		yf: FloatValueFormatter,
+	}
+	if named, isNamed := vs.(NameProvider); isNamed {
+		htf.name = named.GetName()
+	}
+	if vfp, isVfp := vs.(ValueFormatterProvider); isVfp {
+		xf, yf := vfp.GetValueFormatters()
+		if xf != nil {
+			htf.xf = xf
+		}
+		if yf != nil {
+			htf.yf = yf
+		}
+	}
+	return htf
+}
+
+// target returns the hit target for a data point covering a given box.
+func (htf hitTargetFormatter) target(box Box, vx, vy float64) HitTarget {
+	target := HitTarget{
+		Box:    box,
+		XValue: htf.xf(vx),
+		YValue: htf.yf(vy),
+	}
+	target.Tooltip = target.XValue + ", " + target.YValue
+	if len(htf.name) > 0 {
+		target.Tooltip = htf.name + ": " + target.Tooltip
+	}
+	return target
+}
@@ -0,0 +1,101 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestChartSVGHitTargets(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			TimeSeries{
+				Name: "Visits <total>",
+				XValues: []time.Time{
+					time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				YValues: []float64{1, 2, 3},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertNotContains(t, buffer.String(), "hit-target")
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(SVGWithOptions(SVGOptions{HitTargets: true}), buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertEqual(t, 3, strings.Count(raw, `class="hit-target"`))
+	testutil.AssertEqual(t, 3, strings.Count(raw, "<circle"))
+	testutil.AssertContains(t, raw, `data-x="2021-01-02" data-y="2.00"><title>Visits &lt;total&gt;: 2021-01-02, 2.00</title></circle>`)
+
+	// hit targets count toward the path command limit.
+	var commands int
+	for commands = 1; commands < 1000; commands++ {
+		c.Limits = RenderLimits{MaxPathCommands: commands}
+		if c.Render(SVG, bytes.NewBuffer([]byte{})) == nil {
+			break
+		}
+	}
+	withHitTargets := SVGWithOptions(SVGOptions{HitTargets: true})
+	testutil.AssertNotNil(t, c.Render(withHitTargets, bytes.NewBuffer([]byte{})))
+	c.Limits = RenderLimits{MaxPathCommands: commands + 3}
+	testutil.AssertNil(t, c.Render(withHitTargets, bytes.NewBuffer([]byte{})))
+}
+
+func TestCategorySeriesSVGHitTargets(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			CategorySeries{
+				Bars:    true,
+				XValues: []string{"a", "b", "c"},
+				YValues: []float64{-1, 2, 3},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVGWithOptions(SVGOptions{HitTargets: true}), buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertEqual(t, 3, strings.Count(raw, `<rect `))
+	testutil.AssertContains(t, raw, `data-x="a" data-y="-1.00"><title>a, -1.00</title></rect>`)
+	testutil.AssertNotContains(t, raw, `height="-`)
+}
+
+func TestBarChartSVGHitTargets(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Bars: []Value{
+			{Value: 1, Label: "One"},
+			{Value: 2, Label: "Two & Three"},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, bc.Render(SVGWithOptions(SVGOptions{HitTargets: true}), buffer))
+	raw := buffer.String()
+
+	assertWellFormedXML(t, raw)
+	testutil.AssertEqual(t, 2, strings.Count(raw, `class="hit-target"`))
+	testutil.AssertContains(t, raw, `data-x="Two &amp; Three" data-y="2.00"><title>Two &amp; Three: 2.00</title></rect>`)
+
+	bc.IsHorizontal = true
+	buffer.Reset()
+	testutil.AssertNil(t, bc.Render(SVGWithOptions(SVGOptions{HitTargets: true}), buffer))
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), `class="hit-target"`))
+}
@@ -0,0 +1,139 @@
+package chart
+
+import "math"
+
+// Interpolation is an enum for how a line is drawn between the points of a series.
+type Interpolation int
+
+const (
+	// InterpolationUnset is the unset state for interpolation, which draws straight lines.
+	InterpolationUnset Interpolation = 0
+	// InterpolationLinear draws straight lines between points.
+	InterpolationLinear Interpolation = 1
+	// InterpolationStepBefore changes to the value of each point before reaching it.
+	InterpolationStepBefore Interpolation = 2
+	// InterpolationStepAfter holds the value of each point until the next one.
+	InterpolationStepAfter Interpolation = 3
+	// InterpolationStepMiddle changes value half way between points.
+	InterpolationStepMiddle Interpolation = 4
+	// InterpolationMonotoneCubic draws a smooth curve that never overshoots
+	// the points, so it is monotonic wherever the values are.
+	// The points are assumed to be sorted by x.
+	InterpolationMonotoneCubic Interpolation = 5
+	// InterpolationCatmullRom draws a smooth curve through the points, which
+	// may overshoot them.
+	InterpolationCatmullRom Interpolation = 6
+)
+
+// curvePath is a path that interpolated lines are traced onto.
+type curvePath interface {
+	LineTo(x, y float64)
+	CubicCurveTo(cx1, cy1, cx2, cy2, x, y float64)
+}
+
+// traceInterpolated traces a line through the points onto a path whose
+// cursor is already at the first point.
+func traceInterpolated(p curvePath, interpolation Interpolation, xs, ys []float64) {
+	switch interpolation {
+	case InterpolationStepBefore:
+		for i := 1; i < len(xs); i++ {
+			p.LineTo(xs[i-1], ys[i])
+			p.LineTo(xs[i], ys[i])
+		}
+	case InterpolationStepAfter:
+		for i := 1; i < len(xs); i++ {
+			p.LineTo(xs[i], ys[i-1])
+			p.LineTo(xs[i], ys[i])
+		}
+	case InterpolationStepMiddle:
+		for i := 1; i < len(xs); i++ {
+			middle := (xs[i-1] + xs[i]) / 2
+			p.LineTo(middle, ys[i-1])
+			p.LineTo(middle, ys[i])
+			p.LineTo(xs[i], ys[i])
+		}
+	case InterpolationMonotoneCubic:
+		traceMonotoneCubic(p, xs, ys)
+	case InterpolationCatmullRom:
+		traceCatmullRom(p, xs, ys)
+	default:
+		for i := 1; i < len(xs); i++ {
+			p.LineTo(xs[i], ys[i])
+		}
+	}
+}
+
+// traceCatmullRom traces a uniform Catmull-Rom spline as cubic bezier
+// segments, repeating the end points as their own neighbors.
+func traceCatmullRom(p curvePath, xs, ys []float64) {
+	last := len(xs) - 1
+	for i := 1; i <= last; i++ {
+		i0, i3 := MaxInt(i-2, 0), MinInt(i+1, last)
+		p.CubicCurveTo(
+			xs[i-1]+(xs[i]-xs[i0])/6, ys[i-1]+(ys[i]-ys[i0])/6,
+			xs[i]-(xs[i3]-xs[i-1])/6, ys[i]-(ys[i3]-ys[i-1])/6,
+			xs[i], ys[i],
+		)
+	}
+}
+
+// traceMonotoneCubic traces a cubic hermite spline with tangents limited by
+// the Fritsch-Carlson method so it preserves monotonicity.
+func traceMonotoneCubic(p curvePath, xs, ys []float64) {
+	if len(xs) < 3 {
+		for i := 1; i < len(xs); i++ {
+			p.LineTo(xs[i], ys[i])
+		}
+		return
+	}
+
+	tangents := monotoneTangents(xs, ys)
+	for i := 1; i < len(xs); i++ {
+		dx := xs[i] - xs[i-1]
+		if dx == 0 {
+			p.LineTo(xs[i], ys[i])
+			continue
+		}
+		p.CubicCurveTo(
+			xs[i-1]+dx/3, ys[i-1]+tangents[i-1]*dx/3,
+			xs[i]-dx/3, ys[i]-tangents[i]*dx/3,
+			xs[i], ys[i],
+		)
+	}
+}
+
+// monotoneTangents returns the tangent at each point for a monotone cubic spline.
+func monotoneTangents(xs, ys []float64) []float64 {
+	n := len(xs)
+	secants := make([]float64, n-1)
+	for i := range secants {
+		if dx := xs[i+1] - xs[i]; dx != 0 {
+			secants[i] = (ys[i+1] - ys[i]) / dx
+		}
+	}
+
+	tangents := make([]float64, n)
+	tangents[0] = secants[0]
+	tangents[n-1] = secants[n-2]
+	for i := 1; i < n-1; i++ {
+		// the tangent is flat at local extremes.
+		if secants[i-1]*secants[i] > 0 {
+			tangents[i] = (secants[i-1] + secants[i]) / 2
+		}
+	}
+
+	for i, secant := range secants {
+		if secant == 0 {
+			tangents[i], tangents[i+1] = 0, 0
+			continue
+		}
+		alpha, beta := tangents[i]/secant, tangents[i+1]/secant
+		// keep the tangents within the circle of radius 3 that guarantees monotonicity.
+		if length := alpha*alpha + beta*beta; length > 9 {
+			scale := 3 / math.Sqrt(length)
+			tangents[i] = scale * alpha * secant
+			tangents[i+1] = scale * beta * secant
+		}
+	}
+	return tangents
+}
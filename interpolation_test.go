@@ -0,0 +1,140 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+// recordingPath records the commands traced onto it.
+type recordingPath struct {
+	commands []string
+	points   [][2]float64
+}
+
+func (rp *recordingPath) LineTo(x, y float64) {
+	rp.commands = append(rp.commands, fmt.Sprintf("L %v %v", x, y))
+	rp.points = append(rp.points, [2]float64{x, y})
+}
+
+func (rp *recordingPath) CubicCurveTo(cx1, cy1, cx2, cy2, x, y float64) {
+	rp.commands = append(rp.commands, fmt.Sprintf("C %v %v %v %v %v %v", cx1, cy1, cx2, cy2, x, y))
+	rp.points = append(rp.points, [2]float64{cx1, cy1}, [2]float64{cx2, cy2}, [2]float64{x, y})
+}
+
+func TestTraceInterpolatedSteps(t *testing.T) {
+	// replaced new assertions helper
+
+	xs := []float64{0, 10, 20}
+	ys := []float64{0, 5, 15}
+
+	testCases := []struct {
+		Interpolation Interpolation
+		Expected      string
+	}{
+		{InterpolationUnset, "L 10 5|L 20 15"},
+		{InterpolationLinear, "L 10 5|L 20 15"},
+		{InterpolationStepBefore, "L 0 5|L 10 5|L 10 15|L 20 15"},
+		{InterpolationStepAfter, "L 10 0|L 10 5|L 20 5|L 20 15"},
+		{InterpolationStepMiddle, "L 5 0|L 5 5|L 10 5|L 15 5|L 15 15|L 20 15"},
+	}
+	for _, tc := range testCases {
+		rp := &recordingPath{}
+		traceInterpolated(rp, tc.Interpolation, xs, ys)
+		testutil.AssertEqual(t, tc.Expected, strings.Join(rp.commands, "|"))
+	}
+}
+
+func TestTraceInterpolatedCatmullRom(t *testing.T) {
+	// replaced new assertions helper
+
+	rp := &recordingPath{}
+	traceInterpolated(rp, InterpolationCatmullRom, []float64{0, 6, 12}, []float64{0, 6, 0})
+	testutil.AssertEqual(t, "C 1 1 4 6 6 6|C 8 6 11 1 12 0", strings.Join(rp.commands, "|"))
+}
+
+func TestTraceInterpolatedMonotoneCubic(t *testing.T) {
+	// replaced new assertions helper
+
+	// a sharp step overshoots with most splines.
+	xs := []float64{0, 10, 20, 30, 40}
+	ys := []float64{0, 0, 100, 100, 100}
+
+	rp := &recordingPath{}
+	traceInterpolated(rp, InterpolationMonotoneCubic, xs, ys)
+	testutil.AssertLen(t, rp.commands, 4)
+	for _, command := range rp.commands {
+		testutil.AssertTrue(t, strings.HasPrefix(command, "C "))
+	}
+	for _, point := range rp.points {
+		testutil.AssertTrue(t, point[1] >= 0 && point[1] <= 100, point)
+	}
+	// flat segments stay flat.
+	testutil.AssertEqual(t, "C 3.3333333333333335 0 6.666666666666666 0 10 0", rp.commands[0])
+
+	rp = &recordingPath{}
+	traceInterpolated(rp, InterpolationCatmullRom, xs, ys)
+	var overshoots bool
+	for _, point := range rp.points {
+		overshoots = overshoots || point[1] < 0 || point[1] > 100
+	}
+	testutil.AssertTrue(t, overshoots)
+
+	// too few points for a curve.
+	rp = &recordingPath{}
+	traceInterpolated(rp, InterpolationMonotoneCubic, []float64{0, 10}, []float64{0, 10})
+	testutil.AssertEqual(t, "L 10 10", strings.Join(rp.commands, "|"))
+}
+
+func TestDrawLineSeriesInterpolation(t *testing.T) {
+	// replaced new assertions helper
+
+	xrange := &ContinuousRange{Min: 0, Max: 2, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 2, Domain: 100}
+	cs := ContinuousSeries{
+		XValues: []float64{0, 1, 2},
+		YValues: []float64{0, 2, 1},
+	}
+	style := Style{
+		StrokeColor:   ColorBlue,
+		StrokeWidth:   1,
+		FillColor:     ColorLightGray,
+		Interpolation: InterpolationStepAfter,
+	}
+
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.LineSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	// the fill follows the same steps as the stroke.
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100L 50 100L 50 0L 100 0L 100 50L 100 100L 0 100L 0 100"`)
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100L 50 100L 50 0L 100 0L 100 50"`)
+
+	style.Interpolation = InterpolationMonotoneCubic
+	r, err = SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.LineSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `d="M 0 100C16.67,66.67 33.33,0 50,0C66.67,0 83.33,33.33 100,50"`)
+
+	r, err = PNG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.LineSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, style, cs)
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestStyleInheritInterpolation(t *testing.T) {
+	// replaced new assertions helper
+
+	defaults := Style{Interpolation: InterpolationCatmullRom}
+	testutil.AssertEqual(t, InterpolationCatmullRom, Style{}.InheritFrom(defaults).Interpolation)
+	testutil.AssertEqual(t, InterpolationStepBefore, Style{Interpolation: InterpolationStepBefore}.InheritFrom(defaults).Interpolation)
+	testutil.AssertEqual(t, InterpolationUnset, Style{}.GetInterpolation())
+}
@@ -101,13 +101,7 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 				ly := ty - th2
 				lx2 := legendContent.Right - legendPadding.Right
 
-				r.SetStrokeColor(lines[x].GetStrokeColor())
-				r.SetStrokeWidth(lines[x].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
-
-				r.MoveTo(lx, ly)
-				r.LineTo(lx2, ly)
-				r.Stroke()
+				drawLegendLine(r, lines[x], lx, lx2, ly, tb.Height())
 
 				ycursor += tb.Height()
 				legendCount++
@@ -116,6 +110,32 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 	}
 }
 
+// drawLegendLine draws the line of a series in a legend from x1 to x2, or a
+// swatch of its fill as tall as the text if it has a pattern, so series can
+// be told apart without color.
+func drawLegendLine(r Renderer, style Style, x1, x2, y, height int) {
+	r.SetStrokeColor(style.GetStrokeColor())
+	r.SetStrokeWidth(style.GetStrokeWidth())
+	r.SetStrokeDashArray(style.GetStrokeDashArray())
+
+	if pattern := style.GetFillPattern(); pattern != nil {
+		top := y - (height >> 1)
+		r.SetFillColor(style.GetFillColor())
+		setFillPattern(r, pattern)
+		r.MoveTo(x1, top)
+		r.LineTo(x2, top)
+		r.LineTo(x2, top+height)
+		r.LineTo(x1, top+height)
+		r.Close()
+		r.FillStroke()
+		return
+	}
+
+	r.MoveTo(x1, y)
+	r.LineTo(x2, y)
+	r.Stroke()
+}
+
 // LegendThin is a legend that doesn't obscure the chart area.
 func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
@@ -198,13 +218,7 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 				lx = tx + textBox.Width() + lineTextGap
 				ly = ty - th2
 
-				r.SetStrokeColor(lines[index].GetStrokeColor())
-				r.SetStrokeWidth(lines[index].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[index].GetStrokeDashArray())
-
-				r.MoveTo(lx, ly)
-				r.LineTo(lx+lineLengthMinimum, ly)
-				r.Stroke()
+				drawLegendLine(r, lines[index], lx, lx+lineLengthMinimum, ly, textHeight)
 
 				tx += textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
 			}
@@ -310,13 +324,118 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 				ly := ty - th2
 				lx2 := legendContent.Right - legendPadding.Right
 
-				r.SetStrokeColor(lines[x].GetStrokeColor())
-				r.SetStrokeWidth(lines[x].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
+				drawLegendLine(r, lines[x], lx, lx2, ly, tb.Height())
+
+				ycursor += tb.Height()
+				legendCount++
+			}
+		}
+	}
+}
+
+// LegendStackedBar is a legend for stacked and grouped bar charts.
+// Each entry is a color swatch for the values at a given index across the bars,
+// labeled by the first bar that has a label at that index.
+func LegendStackedBar(c *StackedBarChart, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		legendDefaults := Style{
+			FillColor:   drawing.ColorWhite,
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		// DEFAULTS
+		legendPadding := Box{
+			Top:    5,
+			Left:   5,
+			Right:  5,
+			Bottom: 5,
+		}
+		swatchTextGap := 5
+
+		var labels []string
+		var swatches []Style
+		for _, bar := range c.Bars {
+			for index, v := range bar.Values {
+				if index >= len(labels) {
+					labels = append(labels, "")
+					swatches = append(swatches, Style{})
+				}
+				if len(labels[index]) == 0 && len(v.Label) > 0 {
+					labels[index] = v.Label
+					swatches[index] = v.Style.InheritFrom(c.styleDefaultsSegment(index))
+				}
+			}
+		}
+
+		legend := Box{
+			Top:  cb.Top,
+			Left: cb.Left,
+			// bottom and right will be sized by the legend content + relevant padding.
+		}
+
+		legendContent := Box{
+			Top:    legend.Top + legendPadding.Top,
+			Left:   legend.Left + legendPadding.Left,
+			Right:  legend.Left + legendPadding.Left,
+			Bottom: legend.Top + legendPadding.Top,
+		}
+
+		legendStyle.GetTextOptions().WriteToRenderer(r)
+
+		// measure
+		labelCount := 0
+		for x := 0; x < len(labels); x++ {
+			if len(labels[x]) > 0 {
+				tb := r.MeasureText(labels[x])
+				if labelCount > 0 {
+					legendContent.Bottom += DefaultMinimumTickVerticalSpacing
+				}
+				legendContent.Bottom += tb.Height()
+				right := legendContent.Left + tb.Height() + swatchTextGap + tb.Width()
+				legendContent.Right = MaxInt(legendContent.Right, right)
+				labelCount++
+			}
+		}
+
+		legend = legend.Grow(legendContent)
+		legend.Right = legendContent.Right + legendPadding.Right
+		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
+
+		Draw.Box(r, legend, legendStyle)
+
+		ycursor := legendContent.Top
+		tx := legendContent.Left
+		legendCount := 0
+		var label string
+		for x := 0; x < len(labels); x++ {
+			label = labels[x]
+			if len(label) > 0 {
+				if legendCount > 0 {
+					ycursor += DefaultMinimumTickVerticalSpacing
+				}
+
+				legendStyle.GetTextOptions().WriteToRenderer(r)
+				tb := r.MeasureText(label)
+				ty := ycursor + tb.Height()
+
+				Draw.Box(r, Box{
+					Top:    ycursor,
+					Left:   tx,
+					Right:  tx + tb.Height(),
+					Bottom: ty,
+				}, swatches[x])
 
-				r.MoveTo(lx, ly)
-				r.LineTo(lx2, ly)
-				r.Stroke()
+				Draw.Text(r, label, tx+tb.Height()+swatchTextGap, ty, legendStyle)
 
 				ycursor += tb.Height()
 				legendCount++
@@ -324,3 +443,92 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 	}
 }
+
+// LegendColorBar returns a legend renderable that draws a continuous color
+// scale as a vertical bar in the top right of the canvas, labeled with the min
+// and max values it maps.
+// The labels are formatted with the value formatter, which defaults to
+// FloatValueFormatter if it is nil.
+func LegendColorBar(cp ColorProvider, min, max float64, vf ValueFormatter, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		legendDefaults := Style{
+			FillColor:   drawing.ColorWhite,
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		// DEFAULTS
+		legendPadding := Box{
+			Top:    5,
+			Left:   5,
+			Right:  5,
+			Bottom: 5,
+		}
+		barTextGap := 5
+		barWidth := 10
+		barHeight := MinInt(100, cb.Height()>>1)
+
+		if vf == nil {
+			vf = FloatValueFormatter
+		}
+		maxLabel, minLabel := vf(max), vf(min)
+
+		legendStyle.GetTextOptions().WriteToRenderer(r)
+		maxBox := r.MeasureText(maxLabel)
+		minBox := r.MeasureText(minLabel)
+		labelWidth := MaxInt(maxBox.Width(), minBox.Width())
+		halfLabelHeight := MaxInt(maxBox.Height(), minBox.Height()) >> 1
+
+		legend := Box{
+			Top:   cb.Top,
+			Right: cb.Right,
+		}
+		legend.Left = legend.Right - (legendPadding.Left + barWidth + barTextGap + labelWidth + legendPadding.Right)
+		legend.Bottom = legend.Top + legendPadding.Top + halfLabelHeight + barHeight + halfLabelHeight + legendPadding.Bottom
+
+		Draw.Box(r, legend, legendStyle)
+
+		bar := Box{
+			Top:  legend.Top + legendPadding.Top + halfLabelHeight,
+			Left: legend.Left + legendPadding.Left,
+		}
+		bar.Right = bar.Left + barWidth
+		bar.Bottom = bar.Top + barHeight
+
+		// draw the scale from max at the top to min at the bottom, one pixel row at a time.
+		for y := bar.Top; y < bar.Bottom; y++ {
+			v := max - (max-min)*(float64(y-bar.Top)/float64(MaxInt(1, barHeight-1)))
+			r.SetFillColor(cp(v, min, max))
+			r.SetStrokeColor(drawing.ColorTransparent)
+			r.SetStrokeWidth(0)
+			r.MoveTo(bar.Left, y)
+			r.LineTo(bar.Right, y)
+			r.LineTo(bar.Right, y+1)
+			r.LineTo(bar.Left, y+1)
+			r.Close()
+			r.Fill()
+		}
+
+		legendStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(bar.Left, bar.Top)
+		r.LineTo(bar.Right, bar.Top)
+		r.LineTo(bar.Right, bar.Bottom)
+		r.LineTo(bar.Left, bar.Bottom)
+		r.Close()
+		r.Stroke()
+
+		legendStyle.GetTextOptions().WriteToRenderer(r)
+		tx := bar.Right + barTextGap
+		r.Text(maxLabel, tx, bar.Top+(maxBox.Height()>>1))
+		r.Text(minLabel, tx, bar.Bottom+(minBox.Height()>>1))
+	}
+}
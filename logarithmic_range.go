@@ -0,0 +1,186 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultLogarithmicBase is the default base for logarithmic ranges.
+	DefaultLogarithmicBase = 10.0
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*LogarithmicRange)(nil)
+	_ TicksProvider = (*LogarithmicRange)(nil)
+	_ RangeF        = (*LogarithmicRange)(nil)
+)
+
+// LogarithmicRange represents a boundary for a set of strictly positive numbers
+// that is mapped to the domain on a logarithmic scale.
+type LogarithmicRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Base is the logarithm base, it defaults to 10.
+	Base float64
+}
+
+// GetBase returns the logarithm base or the default.
+func (r LogarithmicRange) GetBase() float64 {
+	if r.Base <= 0 || r.Base == 1 {
+		return DefaultLogarithmicBase
+	}
+	return r.Base
+}
+
+// IsDescending returns if the range is descending.
+func (r LogarithmicRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the LogarithmicRange has been set or not.
+func (r LogarithmicRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetMin gets the min value for the logarithmic range.
+func (r LogarithmicRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the logarithmic range.
+func (r *LogarithmicRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the logarithmic range.
+func (r LogarithmicRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the logarithmic range.
+func (r *LogarithmicRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r LogarithmicRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r LogarithmicRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *LogarithmicRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// String returns a simple string for the LogarithmicRange.
+func (r LogarithmicRange) String() string {
+	if r.GetDelta() == 0 {
+		return "LogarithmicRange [empty]"
+	}
+	return fmt.Sprintf("LogarithmicRange [%.2f,%.2f] base %.2f => %d", r.Min, r.Max, r.GetBase(), r.Domain)
+}
+
+// Translate maps a given value into the LogarithmicRange space.
+// Non-positive values are clamped to the start of the range.
+func (r LogarithmicRange) Translate(value float64) int {
+	var ratio float64
+	if value > 0 && r.Min > 0 {
+		// the ratio of logarithms is the same for any base.
+		ratio = math.Log(value/r.Min) / math.Log(r.Max/r.Min)
+	}
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateF maps a given value into the LogarithmicRange space without rounding.
+func (r LogarithmicRange) TranslateF(value float64) float64 {
+	var ratio float64
+	if value > 0 && r.Min > 0 {
+		ratio = math.Log(value/r.Min) / math.Log(r.Max/r.Min)
+	}
+
+	if r.IsDescending() {
+		return float64(r.Domain) - ratio*float64(r.Domain)
+	}
+	return ratio * float64(r.Domain)
+}
+
+// GetTicks returns ticks on each power of the base within the range, with
+// unlabeled minor ticks on the integer multiples of each power in between.
+func (r LogarithmicRange) GetTicks(_ Renderer, _ Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	if r.Min <= 0 || r.Max <= r.Min {
+		return nil
+	}
+
+	base := r.GetBase()
+	minPower := math.Floor(r.log(r.Min))
+	maxPower := math.Ceil(r.log(r.Max))
+
+	// minor ticks only make sense for integer bases.
+	hasMinorTicks := base == math.Trunc(base) && base > 2
+
+	var ticks []Tick
+	for power := minPower; power <= maxPower; power++ {
+		major := math.Pow(base, power)
+		if r.contains(major) {
+			ticks = append(ticks, Tick{Value: major, Label: vf(major)})
+		}
+		if !hasMinorTicks {
+			continue
+		}
+		for multiple := 2.0; multiple < base; multiple++ {
+			minor := major * multiple
+			if r.contains(minor) {
+				ticks = append(ticks, Tick{Value: minor})
+			}
+		}
+	}
+	return ticks
+}
+
+// RoundToPowers expands the min and max of the range out to the nearest
+// powers of the base.
+func (r *LogarithmicRange) RoundToPowers() {
+	if r.Min <= 0 || r.Max <= 0 {
+		return
+	}
+	base := r.GetBase()
+	r.Min = math.Pow(base, math.Floor(r.log(r.Min)))
+	r.Max = math.Pow(base, math.Ceil(r.log(r.Max)))
+}
+
+func (r LogarithmicRange) contains(value float64) bool {
+	// allow for floating point error at the bounds, e.g. math.Pow(10, -1).
+	epsilon := math.Abs(value) * 1e-9
+	return value >= r.Min-epsilon && value <= r.Max+epsilon
+}
+
+func (r LogarithmicRange) log(value float64) float64 {
+	return math.Log(value) / math.Log(r.GetBase())
+}
+
+// isNonPositiveLogarithmicRange returns if the range is logarithmic and
+// includes values that cannot be mapped on a logarithmic scale.
+func isNonPositiveLogarithmicRange(ra Range) bool {
+	lr, isLogarithmic := ra.(*LogarithmicRange)
+	return isLogarithmic && (lr.GetMin() <= 0 || lr.GetMax() <= 0)
+}
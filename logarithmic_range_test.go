@@ -0,0 +1,118 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestLogarithmicRangeTranslate(t *testing.T) {
+	// replaced new assertions helper
+
+	r := LogarithmicRange{Min: 1, Max: 1000, Domain: 300}
+	testutil.AssertEqual(t, 0, r.Translate(1))
+	// translation rounds up, so allow for floating point error.
+	testutil.AssertInDelta(t, 100, float64(r.Translate(10)), 1)
+	testutil.AssertInDelta(t, 200, float64(r.Translate(100)), 1)
+	testutil.AssertEqual(t, 300, r.Translate(1000))
+	testutil.AssertEqual(t, 0, r.Translate(0))
+
+	r.Descending = true
+	testutil.AssertEqual(t, 300, r.Translate(1))
+	testutil.AssertEqual(t, 0, r.Translate(1000))
+}
+
+func TestLogarithmicRangeTranslateF(t *testing.T) {
+	// replaced new assertions helper
+
+	r := LogarithmicRange{Min: 1, Max: 1000, Domain: 300}
+	testutil.AssertEqual(t, 0.0, r.TranslateF(1))
+	testutil.AssertInDelta(t, 100, r.TranslateF(10), 1e-9)
+	testutil.AssertInDelta(t, 150, r.TranslateF(math.Sqrt(1000)), 1e-9)
+
+	r.Descending = true
+	testutil.AssertInDelta(t, 200, r.TranslateF(10), 1e-9)
+}
+
+func TestLogarithmicRangeTranslateBase(t *testing.T) {
+	// replaced new assertions helper
+
+	r := LogarithmicRange{Min: 1, Max: 16, Domain: 400, Base: 2}
+	testutil.AssertEqual(t, 2.0, r.GetBase())
+	testutil.AssertInDelta(t, 100, float64(r.Translate(2)), 1)
+	testutil.AssertInDelta(t, 300, float64(r.Translate(8)), 1)
+}
+
+func TestLogarithmicRangeGetTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	r := LogarithmicRange{Min: 1, Max: 100, Domain: 300}
+	ticks := r.GetTicks(nil, Style{}, IntValueFormatter)
+	testutil.AssertLen(t, ticks, 19)
+	testutil.AssertEqual(t, 1.0, ticks[0].Value)
+	testutil.AssertEqual(t, "1", ticks[0].Label)
+	testutil.AssertEqual(t, 2.0, ticks[1].Value)
+	testutil.AssertEmpty(t, ticks[1].Label)
+	testutil.AssertEqual(t, 10.0, ticks[9].Value)
+	testutil.AssertEqual(t, "10", ticks[9].Label)
+	testutil.AssertEqual(t, 100.0, ticks[18].Value)
+	testutil.AssertEqual(t, "100", ticks[18].Label)
+
+	r = LogarithmicRange{Min: 1, Max: 8, Base: 2}
+	ticks = r.GetTicks(nil, Style{}, IntValueFormatter)
+	testutil.AssertLen(t, ticks, 4)
+}
+
+func TestLogarithmicRangeRoundToPowers(t *testing.T) {
+	// replaced new assertions helper
+
+	r := LogarithmicRange{Min: 3, Max: 4500}
+	r.RoundToPowers()
+	testutil.AssertInDelta(t, 1, r.Min, 0.0000001)
+	testutil.AssertInDelta(t, 10000, r.Max, 0.0000001)
+}
+
+func TestChartLogarithmicRange(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		YAxis: YAxis{
+			Range: &LogarithmicRange{},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3, 4, 5},
+				YValues: []float64{3, 40, 500, 6000, 70000},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+
+	_, yr, _ := c.getRanges()
+	testutil.AssertInDelta(t, 1, yr.GetMin(), 0.0000001)
+	testutil.AssertInDelta(t, 100000, yr.GetMax(), 0.0000001)
+}
+
+func TestChartLogarithmicRangeNonPositive(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		YAxis: YAxis{
+			Range: &LogarithmicRange{},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{0, 10, 100},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, c.Render(PNG, buffer))
+}
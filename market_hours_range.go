@@ -0,0 +1,387 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultMarketOpen is the default start of active hours, as an offset from midnight.
+	DefaultMarketOpen = 9*time.Hour + 30*time.Minute
+	// DefaultMarketClose is the default end of active hours, as an offset from midnight.
+	DefaultMarketClose = 16 * time.Hour
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*MarketHoursRange)(nil)
+	_ TicksProvider = (*MarketHoursRange)(nil)
+	_ RangeF        = (*MarketHoursRange)(nil)
+)
+
+// ActiveHours is a daily window of active time, as wall clock offsets from midnight.
+type ActiveHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// IsZero returns if the window is empty, i.e. the day is inactive.
+func (ah ActiveHours) IsZero() bool {
+	return ah.End <= ah.Start
+}
+
+// Duration returns the length of the window.
+func (ah ActiveHours) Duration() time.Duration {
+	if ah.IsZero() {
+		return 0
+	}
+	return ah.End - ah.Start
+}
+
+// Elapsed returns the active time in the window up to a given offset from midnight.
+func (ah ActiveHours) Elapsed(sinceMidnight time.Duration) time.Duration {
+	if ah.IsZero() || sinceMidnight <= ah.Start {
+		return 0
+	}
+	if sinceMidnight >= ah.End {
+		return ah.End - ah.Start
+	}
+	return sinceMidnight - ah.Start
+}
+
+// WeeklySchedule is the active hours for each day of the week, indexed by time.Weekday.
+type WeeklySchedule [7]ActiveHours
+
+// WeekDaySchedule returns a schedule active between start and end, monday through friday.
+func WeekDaySchedule(start, end time.Duration) WeeklySchedule {
+	var ws WeeklySchedule
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if IsWeekDay(day) {
+			ws[day] = ActiveHours{Start: start, End: end}
+		}
+	}
+	return ws
+}
+
+// IsZero returns if no day in the schedule is active.
+func (ws WeeklySchedule) IsZero() bool {
+	for _, ah := range ws {
+		if !ah.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Duration returns the total active time in a week.
+func (ws WeeklySchedule) Duration() (total time.Duration) {
+	for _, ah := range ws {
+		total += ah.Duration()
+	}
+	return
+}
+
+// MarketHoursRange is a range over timestamps (as produced by TimeToFloat64)
+// that only maps active time onto the domain, compressing nights, weekends
+// and holidays out of the chart.
+type MarketHoursRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Schedule is the active hours per weekday, it defaults to
+	// DefaultMarketOpen to DefaultMarketClose, monday through friday.
+	Schedule WeeklySchedule
+	// Holidays are dates that are inactive regardless of the schedule.
+	// Only the calendar date of each holiday is used.
+	Holidays []time.Time
+
+	// Location is the time zone the schedule is in, it defaults to time.Local.
+	Location *time.Location
+	// ValueFormatter overrides the formatter picked for the chosen tick unit.
+	ValueFormatter ValueFormatter
+}
+
+// GetSchedule returns the schedule or the default.
+func (r MarketHoursRange) GetSchedule() WeeklySchedule {
+	if r.Schedule.IsZero() {
+		return WeekDaySchedule(DefaultMarketOpen, DefaultMarketClose)
+	}
+	return r.Schedule
+}
+
+// GetLocation returns the location or the default.
+func (r MarketHoursRange) GetLocation() *time.Location {
+	if r.Location == nil {
+		return time.Local
+	}
+	return r.Location
+}
+
+// IsDescending returns if the range is descending.
+func (r MarketHoursRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the MarketHoursRange has been set or not.
+func (r MarketHoursRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetMin gets the min value for the range.
+func (r MarketHoursRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the range.
+func (r *MarketHoursRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the range.
+func (r MarketHoursRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the range.
+func (r *MarketHoursRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+// Note that this is the wall time delta and includes inactive time.
+func (r MarketHoursRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r MarketHoursRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *MarketHoursRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// String returns a simple string for the MarketHoursRange.
+func (r MarketHoursRange) String() string {
+	if r.GetDelta() == 0 {
+		return "MarketHoursRange [empty]"
+	}
+	loc := r.GetLocation()
+	return fmt.Sprintf("MarketHoursRange [%s,%s] => %d",
+		TimeFromFloat64(r.Min).In(loc).Format(time.RFC3339),
+		TimeFromFloat64(r.Max).In(loc).Format(time.RFC3339),
+		r.Domain,
+	)
+}
+
+// GetActiveDuration returns the active time between the min and max of the range.
+func (r MarketHoursRange) GetActiveDuration() time.Duration {
+	loc := r.GetLocation()
+	return r.activeBetween(TimeFromFloat64(r.Min).In(loc), TimeFromFloat64(r.Max).In(loc))
+}
+
+// Translate maps a given value into the MarketHoursRange space.
+// Values in inactive periods map to the start of the next active period.
+func (r MarketHoursRange) Translate(value float64) int {
+	ratio := r.getRatio(value)
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateF maps a given value into the MarketHoursRange space without rounding.
+func (r MarketHoursRange) TranslateF(value float64) float64 {
+	ratio := r.getRatio(value)
+
+	if r.IsDescending() {
+		return float64(r.Domain) - ratio*float64(r.Domain)
+	}
+	return ratio * float64(r.Domain)
+}
+
+// getRatio returns the share of the active time in the range that is before the value.
+func (r MarketHoursRange) getRatio(value float64) float64 {
+	loc := r.GetLocation()
+	min := TimeFromFloat64(r.Min).In(loc)
+	max := TimeFromFloat64(r.Max).In(loc)
+
+	total := r.activeBetween(min, max)
+	if total == 0 {
+		return 0
+	}
+	return float64(r.activeBetween(min, TimeFromFloat64(value).In(loc))) / float64(total)
+}
+
+// GetTicks returns ticks aligned to active hours, session opens, or the first
+// active day of each week, month or year; whichever is the smallest unit whose
+// labels fit the domain horizontally.
+// The labels are formatted to match the unit unless ValueFormatter is set.
+func (r MarketHoursRange) GetTicks(re Renderer, defaults Style, vf ValueFormatter) []Tick {
+	loc := r.GetLocation()
+	min := TimeFromFloat64(math.Min(r.Min, r.Max)).In(loc)
+	max := TimeFromFloat64(math.Max(r.Min, r.Max)).In(loc)
+
+	defaults.GetTextOptions().WriteToRenderer(re)
+	for _, unit := range marketHoursTickUnits {
+		label := min.Format(unit.format)
+		if r.ValueFormatter != nil {
+			label = r.ValueFormatter(min)
+		}
+		tickSize := re.MeasureText(label).Width() + DefaultMinimumTickHorizontalSpacing
+		maxTicks := r.Domain / tickSize
+
+		times, fits := r.getTickTimes(unit, min, max, maxTicks)
+		if !fits || len(times) == 0 {
+			continue
+		}
+
+		format := r.ValueFormatter
+		if format == nil {
+			format = TimeValueFormatterWithFormat(unit.format)
+		}
+		ticks := make([]Tick, 0, len(times))
+		for _, t := range times {
+			ticks = append(ticks, Tick{
+				Value: TimeToFloat64(t),
+				Label: format(t),
+			})
+		}
+		if r.IsDescending() {
+			for i, j := 0, len(ticks)-1; i < j; i, j = i+1, j-1 {
+				ticks[i], ticks[j] = ticks[j], ticks[i]
+			}
+		}
+		return ticks
+	}
+
+	return GenerateContinuousTicks(re, &r, false, defaults, vf)
+}
+
+// marketHoursTickUnits are the candidate tick units ordered from smallest to largest.
+var marketHoursTickUnits = []timeTickUnit{
+	{timeTickUnitHour, 1, time.Hour, DefaultDateHourFormat},
+	{timeTickUnitHour, 2, 2 * time.Hour, DefaultDateHourFormat},
+	{timeTickUnitHour, 4, 4 * time.Hour, DefaultDateHourFormat},
+	{timeTickUnitDay, 1, _day, DefaultDateFormat},
+	{timeTickUnitWeek, 1, 7 * _day, DefaultDateFormat},
+	{timeTickUnitMonth, 1, _month, DefaultDateMonthFormat},
+	{timeTickUnitYear, 1, _year, DefaultDateYearFormat},
+}
+
+// getTickTimes walks the active days between min and max and returns the tick
+// times for a unit, or false if there would be more than maxTicks.
+func (r MarketHoursRange) getTickTimes(unit timeTickUnit, min, max time.Time, maxTicks int) (times []time.Time, fits bool) {
+	schedule := r.GetSchedule()
+
+	var previous time.Time
+	var lastActive time.Duration
+	days := DiffDays(min, max)
+	for index := 0; index <= days; index++ {
+		day := min.AddDate(0, 0, index)
+		hours := schedule[day.Weekday()]
+		if hours.IsZero() || r.isHoliday(day) {
+			continue
+		}
+
+		var candidates []time.Time
+		switch unit.kind {
+		case timeTickUnitHour:
+			step := time.Duration(unit.step) * time.Hour
+			first := time.Duration(math.Ceil(float64(hours.Start)/float64(step))) * step
+			for offset := first; offset <= hours.End; offset += step {
+				candidates = append(candidates, ClockTime(day, offset))
+			}
+		case timeTickUnitDay:
+			candidates = append(candidates, ClockTime(day, hours.Start))
+		case timeTickUnitWeek:
+			year, week := day.ISOWeek()
+			pyear, pweek := previous.ISOWeek()
+			if previous.IsZero() || year != pyear || week != pweek {
+				candidates = append(candidates, ClockTime(day, hours.Start))
+			}
+		case timeTickUnitMonth:
+			if previous.IsZero() || day.Year() != previous.Year() || day.Month() != previous.Month() {
+				candidates = append(candidates, ClockTime(day, hours.Start))
+			}
+		default:
+			if previous.IsZero() || day.Year() != previous.Year() {
+				candidates = append(candidates, ClockTime(day, hours.Start))
+			}
+		}
+		previous = day
+
+		for _, t := range candidates {
+			if t.Before(min) || t.After(max) {
+				continue
+			}
+			// a close lands where the next open does, so the open replaces it.
+			active := r.activeBetween(min, t)
+			if len(times) > 0 && active == lastActive {
+				times[len(times)-1] = t
+				continue
+			}
+			lastActive = active
+			times = append(times, t)
+			if len(times) > maxTicks {
+				return nil, false
+			}
+		}
+	}
+	return times, true
+}
+
+// activeBetween returns the active time elapsed from start to end.
+// If end is before start the result is zero.
+func (r MarketHoursRange) activeBetween(start, end time.Time) time.Duration {
+	if !end.After(start) {
+		return 0
+	}
+	return r.activeSinceMidnight(start, end) - r.activeSinceMidnight(start, start)
+}
+
+// activeSinceMidnight returns the active time elapsed from midnight of the
+// anchor's date up to t.
+func (r MarketHoursRange) activeSinceMidnight(anchor, t time.Time) time.Duration {
+	schedule := r.GetSchedule()
+	days := DiffDays(anchor, t)
+	weeks := days / 7
+
+	// every run of 7 days contains each weekday exactly once.
+	total := time.Duration(weeks) * schedule.Duration()
+	for _, holiday := range r.Holidays {
+		if offset := DiffDays(anchor, holiday); offset >= 0 && offset < weeks*7 {
+			total -= schedule[holiday.Weekday()].Duration()
+		}
+	}
+
+	for index := weeks * 7; index < days; index++ {
+		day := anchor.AddDate(0, 0, index)
+		if !r.isHoliday(day) {
+			total += schedule[day.Weekday()].Duration()
+		}
+	}
+
+	if !r.isHoliday(t) {
+		total += schedule[t.Weekday()].Elapsed(SinceMidnight(t))
+	}
+	return total
+}
+
+func (r MarketHoursRange) isHoliday(t time.Time) bool {
+	for _, holiday := range r.Holidays {
+		if DateEquals(t, holiday) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestMarketHoursRangeActiveDuration(t *testing.T) {
+	// replaced new assertions helper
+
+	// 2024-03-11 is a monday.
+	loc := time.UTC
+	mhr := MarketHoursRange{
+		Min:      TimeToFloat64(time.Date(2024, 3, 11, 9, 30, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2024, 3, 18, 16, 0, 0, 0, loc)),
+		Location: loc,
+	}
+	// six full sessions of 6.5 hours.
+	testutil.AssertEqual(t, 39*time.Hour, mhr.GetActiveDuration())
+
+	mhr.Holidays = []time.Time{time.Date(2024, 3, 13, 0, 0, 0, 0, loc)}
+	testutil.AssertEqual(t, 32*time.Hour+30*time.Minute, mhr.GetActiveDuration())
+
+	mhr.Max = TimeToFloat64(time.Date(2024, 3, 11, 12, 0, 0, 0, loc))
+	testutil.AssertEqual(t, 2*time.Hour+30*time.Minute, mhr.GetActiveDuration())
+}
+
+func TestMarketHoursRangeActiveDurationLong(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.UTC
+	mhr := MarketHoursRange{
+		Min:      TimeToFloat64(time.Date(2024, 1, 1, 0, 0, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2025, 1, 1, 0, 0, 0, 0, loc)),
+		Schedule: WeekDaySchedule(9*time.Hour, 17*time.Hour),
+		Holidays: []time.Time{
+			time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+			time.Date(2024, 12, 25, 0, 0, 0, 0, loc),
+		},
+		Location: loc,
+	}
+	// 2024 has 262 weekdays.
+	testutil.AssertEqual(t, 260*8*time.Hour, mhr.GetActiveDuration())
+}
+
+func TestMarketHoursRangeTranslate(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.UTC
+	mhr := MarketHoursRange{
+		Min:      TimeToFloat64(time.Date(2024, 3, 15, 9, 0, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2024, 3, 18, 17, 0, 0, 0, loc)),
+		Schedule: WeekDaySchedule(9*time.Hour, 17*time.Hour),
+		Domain:   1600,
+		Location: loc,
+	}
+
+	testutil.AssertEqual(t, 0, mhr.Translate(mhr.Min))
+	testutil.AssertEqual(t, 1600, mhr.Translate(mhr.Max))
+	// friday close, the weekend and monday open all map to the middle.
+	testutil.AssertEqual(t, 800, mhr.Translate(TimeToFloat64(time.Date(2024, 3, 15, 17, 0, 0, 0, loc))))
+	testutil.AssertEqual(t, 800, mhr.Translate(TimeToFloat64(time.Date(2024, 3, 16, 12, 0, 0, 0, loc))))
+	testutil.AssertEqual(t, 800, mhr.Translate(TimeToFloat64(time.Date(2024, 3, 18, 9, 0, 0, 0, loc))))
+	testutil.AssertEqual(t, 1000, mhr.Translate(TimeToFloat64(time.Date(2024, 3, 18, 11, 0, 0, 0, loc))))
+	testutil.AssertInDelta(t, 1025, mhr.TranslateF(TimeToFloat64(time.Date(2024, 3, 18, 11, 15, 0, 0, loc))), 1e-9)
+
+	mhr.Descending = true
+	testutil.AssertEqual(t, 1600, mhr.Translate(mhr.Min))
+	testutil.AssertInDelta(t, 575, mhr.TranslateF(TimeToFloat64(time.Date(2024, 3, 18, 11, 15, 0, 0, loc))), 1e-9)
+}
+
+func TestMarketHoursRangeGetTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	loc := time.UTC
+	mhr := MarketHoursRange{
+		Min:      TimeToFloat64(time.Date(2024, 3, 11, 9, 30, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2024, 3, 22, 16, 0, 0, 0, loc)),
+		Holidays: []time.Time{time.Date(2024, 3, 13, 0, 0, 0, 0, loc)},
+		Domain:   1024,
+		Location: loc,
+	}
+
+	ticks := mhr.GetTicks(r, Style{Font: f, FontSize: 10}, TimeValueFormatter)
+	testutil.AssertLen(t, ticks, 9)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(loc)
+		testutil.AssertTrue(t, IsWeekDay(tt.Weekday()))
+		testutil.AssertEqual(t, DefaultMarketOpen, SinceMidnight(tt))
+		testutil.AssertNotEqual(t, 13, tt.Day())
+	}
+	testutil.AssertEqual(t, "2024-03-11", ticks[0].Label)
+
+	mhr.Max = TimeToFloat64(time.Date(2024, 3, 11, 16, 0, 0, 0, loc))
+	ticks = mhr.GetTicks(r, Style{Font: f, FontSize: 10}, TimeValueFormatter)
+	testutil.AssertLen(t, ticks, 7)
+	testutil.AssertEqual(t, "03-11 10AM", ticks[0].Label)
+}
+
+func TestMarketHoursRangeGetTicksUnique(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	loc := time.UTC
+	mhr := MarketHoursRange{
+		Min:      TimeToFloat64(time.Date(2024, 1, 8, 9, 0, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2024, 1, 9, 17, 0, 0, 0, loc)),
+		Schedule: WeekDaySchedule(9*time.Hour, 17*time.Hour),
+		Domain:   4000,
+		Location: loc,
+	}
+
+	ticks := mhr.GetTicks(r, Style{Font: f, FontSize: 10}, TimeValueFormatter)
+	testutil.AssertNotEmpty(t, ticks)
+	positions := map[int]string{}
+	for _, tick := range ticks {
+		position := mhr.Translate(tick.Value)
+		_, seen := positions[position]
+		testutil.AssertFalse(t, seen, positions[position], tick.Label)
+		positions[position] = tick.Label
+	}
+	// the next open is labeled rather than the close before it.
+	testutil.AssertEqual(t, "01-09 9AM", positions[2000])
+	testutil.AssertEqual(t, "01-09 5PM", ticks[len(ticks)-1].Label)
+}
+
+func TestChartMarketHoursRange(t *testing.T) {
+	// replaced new assertions helper
+
+	var xvalues []time.Time
+	var yvalues []float64
+	start := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+	for index := 0; index < 24*7; index++ {
+		xvalues = append(xvalues, start.Add(time.Duration(index)*time.Hour))
+		yvalues = append(yvalues, float64(index))
+	}
+
+	c := Chart{
+		XAxis: XAxis{
+			Range: &MarketHoursRange{Location: time.UTC},
+		},
+		Series: []Series{
+			TimeSeries{XValues: xvalues, YValues: yvalues},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
@@ -0,0 +1,20 @@
+package chart
+
+import "github.com/userstyles-world/go-chart/v2/drawing"
+
+// PatternRenderer is an optional extension of Renderer for renderers that
+// can draw a hatch pattern over a shape's fill, so it can be told apart
+// without color.
+// Charts check for it with a type assertion, so renderers that don't
+// implement it fill with the fill color instead.
+type PatternRenderer interface {
+	// SetFillPattern sets a pattern to fill with until the fill color is next set.
+	SetFillPattern(pattern *drawing.Pattern)
+}
+
+// setFillPattern sets the fill pattern if the renderer supports it.
+func setFillPattern(r Renderer, pattern *drawing.Pattern) {
+	if pr, isPatternRenderer := unwrapRenderer(r).(PatternRenderer); isPatternRenderer {
+		pr.SetFillPattern(pattern)
+	}
+}
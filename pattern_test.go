@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/drawing"
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestStyleFillPattern(t *testing.T) {
+	// replaced new assertions helper
+
+	pattern := drawing.NewPattern(drawing.PatternTypeCross, ColorBlue)
+	set := Style{FillPattern: pattern}
+	testutil.AssertTrue(t, set.ShouldDrawFill())
+	testutil.AssertEqual(t, pattern, set.GetFillOptions().FillPattern)
+	testutil.AssertEqual(t, pattern, set.GetFillAndStrokeOptions().FillPattern)
+	testutil.AssertEqual(t, pattern, Style{}.InheritFrom(set).FillPattern)
+	testutil.AssertNil(t, Style{}.GetFillPattern())
+}
+
+func TestVectorRendererPattern(t *testing.T) {
+	// replaced new assertions helper
+
+	pattern := drawing.NewPattern(drawing.PatternTypeHorizontal, ColorBlue)
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.Box(r, Box{Top: 10, Left: 10, Right: 40, Bottom: 40}, Style{FillColor: ColorWhite, FillPattern: pattern})
+	Draw.Box(r, Box{Top: 50, Left: 10, Right: 40, Bottom: 90}, Style{FillColor: ColorWhite, FillPattern: pattern})
+	// the background is part of the pattern, so a different fill is a different pattern.
+	Draw.Box(r, Box{Top: 10, Left: 50, Right: 90, Bottom: 40}, Style{FillPattern: pattern})
+	// patterns take precedence over gradients.
+	Draw.Box(r, Box{Top: 50, Left: 50, Right: 90, Bottom: 90}, Style{
+		FillPattern:  drawing.NewPattern(drawing.PatternTypeDots, ColorRed),
+		FillGradient: drawing.NewVerticalGradient(ColorRed, ColorBlue),
+	})
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	svg := buffer.String()
+	assertWellFormedXML(t, svg)
+
+	id, markup := patternDefinition(pattern, ColorWhite)
+	testutil.AssertEqual(t, 1, strings.Count(svg, markup))
+	testutil.AssertContains(t, markup, `<pattern id="`+id+`" patternUnits="userSpaceOnUse" width="8" height="8"><rect width="8" height="8" fill="rgba(255,255,255,1)"/><path d="M 0 4L 8 4" stroke="rgba(0,116,217,1)" stroke-width="1" fill="none"/></pattern>`)
+	testutil.AssertEqual(t, 2, strings.Count(svg, "fill:url(#"+id+")"))
+	testutil.AssertEqual(t, 3, strings.Count(svg, "<pattern "))
+	testutil.AssertContains(t, svg, `<circle cx="4" cy="4" r="1" fill="rgba(217,0,116,1)"/>`)
+	testutil.AssertNotContains(t, svg, "<linearGradient")
+
+	// class names keep the pattern as a presentation attribute.
+	r, err = SVG(100, 100)
+	testutil.AssertNil(t, err)
+	Draw.Box(r, Box{Top: 10, Left: 10, Right: 40, Bottom: 40}, Style{ClassName: "bar", FillColor: ColorWhite, FillPattern: pattern})
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `class="bar fill" fill="url(#`+id+`)"`)
+}
+
+func TestLegendPatternSwatch(t *testing.T) {
+	// replaced new assertions helper
+
+	pattern := drawing.NewPattern(drawing.PatternTypeDiagonal, ColorBlue)
+	graph := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "Patterned",
+				Style:   Style{StrokeColor: ColorBlue, StrokeWidth: 1, FillPattern: pattern},
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			ContinuousSeries{
+				Name:    "Plain",
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{3.0, 2.0, 1.0},
+			},
+		},
+	}
+	graph.Elements = []Renderable{
+		Legend(&graph),
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(SVG, buffer))
+	id, _ := patternDefinition(pattern, drawing.Color{})
+	// once for the series, and once for its swatch in the legend.
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), "fill:url(#"+id+")"))
+
+	buffer.Reset()
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
@@ -0,0 +1,378 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// PieChart is a chart that draws sections of a circle based on percentages.
+// With a HoleSize set, it draws sections of a ring instead.
+type PieChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+	SliceStyle Style
+
+	// HoleSize is the radius of the hole as a ratio of the outer radius.
+	// A zero value draws a pie without a hole.
+	HoleSize float64
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Values   []Value
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (pc PieChart) GetDPI(defaults ...float64) float64 {
+	if pc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return pc.DPI
+}
+
+// GetFont returns the text font.
+func (pc PieChart) GetFont() *truetype.Font {
+	if pc.Font == nil {
+		return pc.defaultFont
+	}
+	return pc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (pc PieChart) GetWidth() int {
+	if pc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return pc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (pc PieChart) GetHeight() int {
+	if pc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return pc.Height
+}
+
+// GetHoleSize returns the hole size ratio, or zero if there is no hole.
+func (pc PieChart) GetHoleSize() float64 {
+	if pc.HoleSize <= 0 || pc.HoleSize >= 1 {
+		return 0
+	}
+	return pc.HoleSize
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(pc.Values) == 0 {
+		return errors.New("please provide at least one value")
+	}
+
+	r, err := rp(pc.GetWidth(), pc.GetHeight())
+	if err != nil {
+		return err
+	}
+	setAccessibleText(r, pc.Title, pc.getDescription())
+
+	if pc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		pc.defaultFont = defaultFont
+	}
+	r.SetDPI(pc.GetDPI(DefaultDPI))
+
+	canvasBox := pc.getDefaultCanvasBox()
+	canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+
+	pc.drawBackground(r)
+	pc.drawCanvas(r, canvasBox)
+
+	finalValues, err := pc.finalizeValues(pc.Values)
+	if err != nil {
+		return err
+	}
+	pc.drawSlices(r, canvasBox, finalValues)
+	pc.drawTitle(r)
+	for _, a := range pc.Elements {
+		a(r, canvasBox, pc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// getDescription returns a summary of the slices, for renderers that support it.
+func (pc PieChart) getDescription() string {
+	labels := make([]string, 0, len(pc.Values))
+	for _, item := range pc.Values {
+		labels = append(labels, item.Label)
+	}
+	return describeItems(pc.getKind(), "slice", "slices", labels)
+}
+
+// getKind returns the kind of chart for descriptions and errors.
+func (pc PieChart) getKind() string {
+	if pc.GetHoleSize() > 0 {
+		return "Donut chart"
+	}
+	return "Pie chart"
+}
+
+func (pc PieChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  pc.GetWidth(),
+		Bottom: pc.GetHeight(),
+	}, pc.getBackgroundStyle())
+}
+
+func (pc PieChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, pc.getCanvasStyle())
+}
+
+func (pc PieChart) drawTitle(r Renderer) {
+	if len(pc.Title) > 0 && !pc.TitleStyle.Hidden {
+		Draw.TextWithin(r, pc.Title, pc.Box(), pc.styleDefaultsTitle())
+	}
+}
+
+func (pc PieChart) finalizeValues(values []Value) ([]Value, error) {
+	finalValues := Values(values).Normalize()
+	if len(finalValues) == 0 {
+		return nil, fmt.Errorf("%s must contain at least (1) non-zero value", strings.ToLower(pc.getKind()))
+	}
+	return finalValues, nil
+}
+
+func (pc PieChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
+	cx, cy := canvasBox.Center()
+	diameter := MinInt(canvasBox.Width(), canvasBox.Height())
+	radius := float64(diameter >> 1)
+	innerRadius := radius * pc.GetHoleSize()
+	labelRadius := (radius * 2.0) / 3.0
+	if innerRadius > 0 {
+		labelRadius = (radius + innerRadius) / 2.0
+	}
+
+	// draw the pie slices
+	var rads, delta, delta2, total float64
+	var sx, sy, lx, ly int
+
+	if innerRadius > 0 {
+		// draw ring sections, out along the outer arc and back along the inner one.
+		for index, v := range values {
+			v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+
+			rads = PercentToRadians(total)
+			delta = PercentToRadians(v.Value)
+
+			sx, sy = CirclePoint(cx, cy, radius, rads)
+			r.MoveTo(sx, sy)
+			arcTo(r, cx, cy, radius, rads, delta)
+			arcTo(r, cx, cy, innerRadius, rads+delta, -delta)
+			r.Close()
+			r.FillStroke()
+			total = total + v.Value
+		}
+	} else if len(values) == 1 {
+		values[0].Style.InheritFrom(pc.stylePieChartValue(0)).WriteToRenderer(r)
+		r.Circle(radius, cx, cy)
+		r.FillStroke()
+	} else {
+		for index, v := range values {
+			v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+
+			r.MoveTo(cx, cy)
+			rads = PercentToRadians(total)
+			delta = PercentToRadians(v.Value)
+
+			arcTo(r, cx, cy, radius, rads, delta)
+
+			r.LineTo(cx, cy)
+			r.Close()
+			r.FillStroke()
+			total = total + v.Value
+		}
+	}
+
+	// draw the labels
+	total = 0
+	for index, v := range values {
+		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+		if len(v.Label) > 0 {
+			delta2 = PercentToRadians(total + (v.Value / 2.0))
+			lx, ly = CirclePoint(cx, cy, labelRadius, delta2)
+
+			tb := r.MeasureText(v.Label)
+			lx = lx - (tb.Width() >> 1)
+			ly = ly + (tb.Height() >> 1)
+
+			if lx < 0 {
+				lx = 0
+			}
+			if ly < 0 {
+				ly = 0
+			}
+			r.Text(v.Label, lx, ly)
+		}
+		total = total + v.Value
+	}
+}
+
+func (pc PieChart) getDefaultCanvasBox() Box {
+	return pc.Box()
+}
+
+func (pc PieChart) getCircleAdjustedCanvasBox(canvasBox Box) Box {
+	circleDiameter := MinInt(canvasBox.Width(), canvasBox.Height())
+
+	square := Box{
+		Right:  circleDiameter,
+		Bottom: circleDiameter,
+	}
+
+	return canvasBox.Fit(square)
+}
+
+func (pc PieChart) getBackgroundStyle() Style {
+	return pc.Background.InheritFrom(pc.styleDefaultsBackground())
+}
+
+func (pc PieChart) getCanvasStyle() Style {
+	return pc.Canvas.InheritFrom(pc.styleDefaultsCanvas())
+}
+
+func (pc PieChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   pc.GetColorPalette().CanvasColor(),
+		StrokeColor: pc.GetColorPalette().CanvasStrokeColor(),
+	}
+}
+
+func (pc PieChart) stylePieChartValue(index int) Style {
+	return pc.SliceStyle.InheritFrom(Style{
+		StrokeColor: ColorWhite,
+		StrokeWidth: 5.0,
+		FillColor:   pc.GetColorPalette().GetSeriesColor(index),
+		FontSize:    pc.getScaledFontSize(),
+		FontColor:   pc.GetColorPalette().TextColor(),
+		Font:        pc.GetFont(),
+	})
+}
+
+func (pc PieChart) getScaledFontSize() float64 {
+	effectiveDimension := MinInt(pc.GetWidth(), pc.GetHeight())
+	switch {
+	case effectiveDimension >= 2048:
+		return 48.0
+	case effectiveDimension >= 1024:
+		return 24.0
+	case effectiveDimension > 512:
+		return 18.0
+	case effectiveDimension > 256:
+		return 12.0
+	default:
+		return 10.0
+	}
+}
+
+func (pc PieChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   pc.GetColorPalette().BackgroundColor(),
+		StrokeColor: pc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (pc PieChart) styleDefaultsElements() Style {
+	return Style{
+		Font: pc.GetFont(),
+	}
+}
+
+func (pc PieChart) styleDefaultsTitle() Style {
+	return pc.TitleStyle.InheritFrom(Style{
+		FontColor:           pc.GetColorPalette().TextColor(),
+		Font:                pc.GetFont(),
+		FontSize:            pc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (pc PieChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(pc.GetWidth(), pc.GetHeight())
+	switch {
+	case effectiveDimension >= 2048:
+		return 48
+	case effectiveDimension >= 1024:
+		return 24
+	case effectiveDimension >= 512:
+		return 18
+	case effectiveDimension >= 256:
+		return 12
+	default:
+		return 10
+	}
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (pc PieChart) GetColorPalette() ColorPalette {
+	if pc.ColorPalette != nil {
+		return pc.ColorPalette
+	}
+	return AlternateColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (pc PieChart) Box() Box {
+	dpr := pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  pc.GetWidth() - dpr,
+		Bottom: pc.GetHeight() - dpb,
+	}
+}
+
+// _arcSegmentRadians is the maximum sweep of a single line segment when
+// approximating an arc (roughly 2 degrees).
+const _arcSegmentRadians = math.Pi / 90.0
+
+// arcTo approximates a circular arc with line segments, starting at the
+// `start` angle and sweeping clockwise by `delta` radians.
+// It assumes the path cursor has already been placed by the caller.
+func arcTo(r Renderer, cx, cy int, radius, start, delta float64) {
+	segments := int(math.Ceil(math.Abs(delta) / _arcSegmentRadians))
+	if segments < 1 {
+		segments = 1
+	}
+	step := delta / float64(segments)
+
+	var x, y int
+	for i := 0; i <= segments; i++ {
+		x, y = CirclePoint(cx, cy, radius, start+(step*float64(i)))
+		r.LineTo(x, y)
+	}
+}
@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestPieChart(t *testing.T) {
+	// replaced new assertions helper
+
+	pie := PieChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 10, Label: "Blue"},
+			{Value: 9, Label: "Green"},
+			{Value: 8, Label: "Gray"},
+			{Value: 7, Label: "Orange"},
+			{Value: 6, Label: "HEANG"},
+			{Value: 5, Label: "??"},
+			{Value: 2, Label: "!!"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pie.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, pie.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestPieChartSingleValue(t *testing.T) {
+	// replaced new assertions helper
+
+	pie := PieChart{
+		Values: []Value{
+			{Value: 10, Label: "Only"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pie.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestPieChartDropsZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	pie := PieChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 5, Label: "Blue"},
+			{Value: 5, Label: "Green"},
+			{Value: 0, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pie.Render(PNG, b))
+}
+
+func TestPieChartAllZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	pie := PieChart{
+		Canvas: Style{
+			FillColor: ColorLightGray,
+		},
+		Values: []Value{
+			{Value: 0, Label: "Blue"},
+			{Value: 0, Label: "Green"},
+			{Value: 0, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, pie.Render(PNG, b))
+}
+
+func TestPieChartNoValues(t *testing.T) {
+	// replaced new assertions helper
+
+	pie := PieChart{}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, pie.Render(PNG, b))
+}
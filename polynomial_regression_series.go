@@ -0,0 +1,225 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/userstyles-world/go-chart/v2/matrix"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*PolynomialRegressionSeries)(nil)
+	_ FirstValuesProvider = (*PolynomialRegressionSeries)(nil)
+	_ LastValuesProvider  = (*PolynomialRegressionSeries)(nil)
+)
+
+// PolynomialRegressionSeries implements a polynomial regression over a given
+// inner series.
+type PolynomialRegressionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Limit       int
+	Offset      int
+	Degree      int
+	InnerSeries ValuesProvider
+
+	// Extrapolate is the number of points to plot beyond the last x value of
+	// the window, spaced by the average x step of the window.
+	Extrapolate int
+
+	coeffs  []float64
+	avgx    float64
+	stddevx float64
+}
+
+// GetName returns the name of the time series.
+func (prs PolynomialRegressionSeries) GetName() string {
+	return prs.Name
+}
+
+// GetStyle returns the line style.
+func (prs PolynomialRegressionSeries) GetStyle() Style {
+	return prs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (prs PolynomialRegressionSeries) GetYAxis() YAxisType {
+	return prs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (prs PolynomialRegressionSeries) Len() int {
+	return prs.getWindowLen() + prs.Extrapolate
+}
+
+// GetLimit returns the window size.
+func (prs PolynomialRegressionSeries) GetLimit() int {
+	if prs.Limit == 0 {
+		return prs.InnerSeries.Len()
+	}
+	return prs.Limit
+}
+
+// GetEndIndex returns the effective window end, exclusive.
+func (prs PolynomialRegressionSeries) GetEndIndex() int {
+	windowEnd := prs.GetOffset() + prs.GetLimit()
+	return MinInt(windowEnd, prs.InnerSeries.Len())
+}
+
+// GetOffset returns the data offset.
+func (prs PolynomialRegressionSeries) GetOffset() int {
+	if prs.Offset == 0 {
+		return 0
+	}
+	return prs.Offset
+}
+
+// Coefficients returns the computed polynomial coefficients, lowest order first.
+// The polynomial is evaluated over the x values normalized by their mean and
+// standard deviation within the window.
+func (prs *PolynomialRegressionSeries) Coefficients() ([]float64, error) {
+	if err := prs.ensureCoefficients(); err != nil {
+		return nil, err
+	}
+	return prs.coeffs, nil
+}
+
+// Validate validates the series.
+func (prs *PolynomialRegressionSeries) Validate() error {
+	if prs.InnerSeries == nil {
+		return fmt.Errorf("polynomial regression series requires InnerSeries to be set")
+	}
+	if prs.Degree < 0 {
+		return fmt.Errorf("polynomial regression series requires a non-negative Degree")
+	}
+	if prs.Extrapolate < 0 {
+		return fmt.Errorf("polynomial regression series requires a non-negative Extrapolate")
+	}
+
+	windowLen := prs.getWindowLen()
+	if windowLen <= prs.Degree {
+		return fmt.Errorf("invalid window; polynomial of degree %d requires more than %d values but window has %d", prs.Degree, prs.Degree, windowLen)
+	}
+
+	return prs.ensureCoefficients()
+}
+
+// GetValues returns the series value for a given index.
+func (prs *PolynomialRegressionSeries) GetValues(index int) (x, y float64) {
+	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
+		return
+	}
+	x = prs.getXValue(index)
+	y = prs.apply(x)
+	return
+}
+
+// GetFirstValues computes the first poly regression value.
+func (prs *PolynomialRegressionSeries) GetFirstValues() (x, y float64) {
+	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
+		return
+	}
+	return prs.GetValues(0)
+}
+
+// GetLastValues computes the last poly regression value, including any extrapolated values.
+func (prs *PolynomialRegressionSeries) GetLastValues() (x, y float64) {
+	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
+		return
+	}
+	return prs.GetValues(prs.Len() - 1)
+}
+
+// Render renders the series.
+func (prs *PolynomialRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := prs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, prs)
+}
+
+//
+// internal helpers
+//
+
+func (prs PolynomialRegressionSeries) getWindowLen() int {
+	return MaxInt(0, prs.GetEndIndex()-prs.GetOffset())
+}
+
+// getXValue returns the x value for an index, stepping past the window for extrapolated indexes.
+func (prs PolynomialRegressionSeries) getXValue(index int) float64 {
+	startIndex := prs.GetOffset()
+	windowLen := prs.getWindowLen()
+	if index < windowLen {
+		x, _ := prs.InnerSeries.GetValues(startIndex + index)
+		return x
+	}
+
+	x0, _ := prs.InnerSeries.GetValues(startIndex)
+	xn, _ := prs.InnerSeries.GetValues(startIndex + windowLen - 1)
+
+	var step float64
+	if windowLen > 1 {
+		step = (xn - x0) / float64(windowLen-1)
+	}
+	return xn + step*float64(index-windowLen+1)
+}
+
+// apply evaluates the polynomial at a given x value.
+// It returns NaN if the coefficients cannot be computed.
+func (prs *PolynomialRegressionSeries) apply(v float64) (out float64) {
+	if err := prs.ensureCoefficients(); err != nil {
+		return math.NaN()
+	}
+
+	nv := prs.normalize(v)
+	for index, coeff := range prs.coeffs {
+		out = out + (coeff * math.Pow(nv, float64(index)))
+	}
+	return
+}
+
+func (prs *PolynomialRegressionSeries) normalize(xvalue float64) float64 {
+	if prs.stddevx == 0 {
+		return xvalue - prs.avgx
+	}
+	return (xvalue - prs.avgx) / prs.stddevx
+}
+
+func (prs *PolynomialRegressionSeries) ensureCoefficients() error {
+	if prs.coeffs != nil {
+		return nil
+	}
+
+	xvalues, yvalues := prs.values()
+
+	prs.avgx = Seq{Array(xvalues)}.Average()
+	prs.stddevx = Seq{Array(xvalues)}.StdDev()
+	for index := range xvalues {
+		xvalues[index] = prs.normalize(xvalues[index])
+	}
+
+	coeffs, err := matrix.Poly(xvalues, yvalues, prs.Degree)
+	if err != nil {
+		return err
+	}
+	prs.coeffs = coeffs
+	return nil
+}
+
+func (prs *PolynomialRegressionSeries) values() (xvalues, yvalues []float64) {
+	startIndex := prs.GetOffset()
+	endIndex := prs.GetEndIndex()
+
+	xvalues = make([]float64, endIndex-startIndex)
+	yvalues = make([]float64, endIndex-startIndex)
+
+	for index := startIndex; index < endIndex; index++ {
+		x, y := prs.InnerSeries.GetValues(index)
+		xvalues[index-startIndex] = x
+		yvalues[index-startIndex] = y
+	}
+
+	return
+}
@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testQuadraticSeries() ContinuousSeries {
+	xvalues := LinearRange(0, 10)
+	yvalues := make([]float64, len(xvalues))
+	for index, x := range xvalues {
+		yvalues[index] = 2*x*x - 3*x + 1
+	}
+	return ContinuousSeries{
+		XValues: xvalues,
+		YValues: yvalues,
+	}
+}
+
+func TestPolynomialRegressionSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	prs := &PolynomialRegressionSeries{
+		Degree:      2,
+		InnerSeries: testQuadraticSeries(),
+	}
+	testutil.AssertNil(t, prs.Validate())
+	testutil.AssertEqual(t, 11, prs.Len())
+
+	for index := 0; index < prs.Len(); index++ {
+		x, y := prs.GetValues(index)
+		testutil.AssertInDelta(t, float64(index), x, 0.0000001)
+		testutil.AssertInDelta(t, 2*x*x-3*x+1, y, 0.0001)
+	}
+
+	x0, y0 := prs.GetFirstValues()
+	testutil.AssertInDelta(t, 0.0, x0, 0.0000001)
+	testutil.AssertInDelta(t, 1.0, y0, 0.0001)
+}
+
+func TestPolynomialRegressionSeriesExtrapolate(t *testing.T) {
+	// replaced new assertions helper
+
+	prs := &PolynomialRegressionSeries{
+		Degree:      2,
+		Extrapolate: 5,
+		InnerSeries: testQuadraticSeries(),
+	}
+	testutil.AssertNil(t, prs.Validate())
+	testutil.AssertEqual(t, 16, prs.Len())
+
+	x, y := prs.GetLastValues()
+	testutil.AssertInDelta(t, 15.0, x, 0.0000001)
+	testutil.AssertInDelta(t, 2*15.0*15.0-3*15.0+1, y, 0.001)
+}
+
+func TestPolynomialRegressionSeriesWindow(t *testing.T) {
+	// replaced new assertions helper
+
+	prs := &PolynomialRegressionSeries{
+		Degree:      1,
+		Offset:      2,
+		Limit:       4,
+		InnerSeries: testQuadraticSeries(),
+	}
+	testutil.AssertNil(t, prs.Validate())
+	testutil.AssertEqual(t, 4, prs.Len())
+	testutil.AssertEqual(t, 6, prs.GetEndIndex())
+
+	x0, _ := prs.GetFirstValues()
+	testutil.AssertInDelta(t, 2.0, x0, 0.0000001)
+	xn, _ := prs.GetLastValues()
+	testutil.AssertInDelta(t, 5.0, xn, 0.0000001)
+}
+
+func TestPolynomialRegressionSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, (&PolynomialRegressionSeries{Degree: 2}).Validate())
+	testutil.AssertNotNil(t, (&PolynomialRegressionSeries{
+		Degree:      2,
+		Limit:       2,
+		InnerSeries: testQuadraticSeries(),
+	}).Validate())
+	testutil.AssertNotNil(t, (&PolynomialRegressionSeries{
+		Degree:      2,
+		Extrapolate: -1,
+		InnerSeries: testQuadraticSeries(),
+	}).Validate())
+}
+
+func TestPolynomialRegressionSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := testQuadraticSeries()
+	graph := Chart{
+		Series: []Series{
+			inner,
+			&PolynomialRegressionSeries{
+				Degree:      2,
+				Extrapolate: 3,
+				InnerSeries: inner,
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
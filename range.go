@@ -41,3 +41,19 @@ type Range interface {
 	// Translate the range to the domain.
 	Translate(value float64) int
 }
+
+// RangeF is an optional extension of Range that translates values to the
+// domain without rounding them to whole pixels.
+type RangeF interface {
+	// TranslateF translates the range to the domain at float precision.
+	TranslateF(value float64) float64
+}
+
+// translateF translates a value to the domain at float precision if the range
+// supports it, and to the nearest whole pixel otherwise.
+func translateF(ra Range, value float64) float64 {
+	if rf, isRangeF := ra.(RangeF); isRangeF {
+		return rf.TranslateF(value)
+	}
+	return float64(ra.Translate(value))
+}
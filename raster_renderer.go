@@ -69,6 +69,18 @@ func (rr *rasterRenderer) SetStrokeDashArray(dashArray []float64) {
 // SetFillColor implements the interface method.
 func (rr *rasterRenderer) SetFillColor(c drawing.Color) {
 	rr.s.FillColor = c
+	rr.s.FillGradient = nil
+	rr.s.FillPattern = nil
+}
+
+// SetFillGradient implements the GradientRenderer interface method.
+func (rr *rasterRenderer) SetFillGradient(gradient *drawing.Gradient) {
+	rr.s.FillGradient = gradient
+}
+
+// SetFillPattern implements the PatternRenderer interface method.
+func (rr *rasterRenderer) SetFillPattern(pattern *drawing.Pattern) {
+	rr.s.FillPattern = pattern
 }
 
 // MoveTo implements the interface method.
@@ -86,6 +98,31 @@ func (rr *rasterRenderer) QuadCurveTo(cx, cy, x, y int) {
 	rr.gc.QuadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
 }
 
+// CubicCurveTo implements the interface method.
+func (rr *rasterRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	rr.gc.CubicCurveTo(float64(cx1), float64(cy1), float64(cx2), float64(cy2), float64(x), float64(y))
+}
+
+// MoveToF implements the RendererF interface method.
+func (rr *rasterRenderer) MoveToF(x, y float64) {
+	rr.gc.MoveTo(x, y)
+}
+
+// LineToF implements the RendererF interface method.
+func (rr *rasterRenderer) LineToF(x, y float64) {
+	rr.gc.LineTo(x, y)
+}
+
+// QuadCurveToF implements the RendererF interface method.
+func (rr *rasterRenderer) QuadCurveToF(cx, cy, x, y float64) {
+	rr.gc.QuadCurveTo(cx, cy, x, y)
+}
+
+// CubicCurveToF implements the RendererF interface method.
+func (rr *rasterRenderer) CubicCurveToF(cx1, cy1, cx2, cy2, x, y float64) {
+	rr.gc.CubicCurveTo(cx1, cy1, cx2, cy2, x, y)
+}
+
 // Close implements the interface method.
 func (rr *rasterRenderer) Close() {
 	rr.gc.Close()
@@ -101,24 +138,37 @@ func (rr *rasterRenderer) Stroke() {
 
 // Fill implements the interface method.
 func (rr *rasterRenderer) Fill() {
-	rr.gc.SetFillColor(rr.s.FillColor)
+	rr.setFill()
 	rr.gc.Fill()
 }
 
 // FillStroke implements the interface method.
 func (rr *rasterRenderer) FillStroke() {
-	rr.gc.SetFillColor(rr.s.FillColor)
+	rr.setFill()
 	rr.gc.SetStrokeColor(rr.s.StrokeColor)
 	rr.gc.SetLineWidth(rr.s.StrokeWidth)
 	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
 	rr.gc.FillStroke()
 }
 
+// setFill passes the fill color, gradient and pattern to the graphic context.
+func (rr *rasterRenderer) setFill() {
+	rr.gc.SetFillColor(rr.s.FillColor)
+	if rr.s.FillGradient != nil {
+		rr.gc.SetFillGradient(rr.s.FillGradient)
+	}
+	if rr.s.FillPattern != nil {
+		rr.gc.SetFillPattern(rr.s.FillPattern)
+	}
+}
+
 // Circle fully draws a circle at a given point but does not apply the fill or stroke.
 func (rr *rasterRenderer) Circle(radius float64, x, y int) {
-	xf := float64(x)
-	yf := float64(y)
+	rr.CircleF(radius, float64(x), float64(y))
+}
 
+// CircleF implements the RendererF interface method.
+func (rr *rasterRenderer) CircleF(radius, xf, yf float64) {
 	rr.gc.MoveTo(xf-radius, yf)                            // 9
 	rr.gc.QuadCurveTo(xf-radius, yf-radius, xf, yf-radius) // 12
 	rr.gc.QuadCurveTo(xf+radius, yf-radius, xf+radius, yf) // 3
@@ -215,6 +265,13 @@ func (rr *rasterRenderer) ClearTextRotation() {
 	rr.rotateRadians = nil
 }
 
+// BeginGroup implements the GroupRenderer interface method.
+// Raster images have no structure, so it is a no-op.
+func (rr *rasterRenderer) BeginGroup(group Group) {}
+
+// EndGroup implements the GroupRenderer interface method.
+func (rr *rasterRenderer) EndGroup() {}
+
 // Save implements the interface method.
 func (rr *rasterRenderer) Save(w io.Writer) error {
 	if typed, isTyped := w.(RGBACollector); isTyped {
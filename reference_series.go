@@ -0,0 +1,324 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*ReferenceLineSeries)(nil)
+	_ ZOrderProvider      = (*ReferenceLineSeries)(nil)
+	_ ValueBoundsProvider = (*ReferenceLineSeries)(nil)
+	_ Series              = (*BandSeries)(nil)
+	_ ZOrderProvider      = (*BandSeries)(nil)
+	_ ValueBoundsProvider = (*BandSeries)(nil)
+	_ Series              = (*RegionSeries)(nil)
+	_ ZOrderProvider      = (*RegionSeries)(nil)
+	_ ValueBoundsProvider = (*RegionSeries)(nil)
+)
+
+// ReferenceLineSeries is a labeled line across the canvas at a value, such
+// as a target or a threshold.
+//
+// A horizontal line is at a y value on the series' y axis, and a vertical
+// line at an x value; for a time axis use TimeToFloat64.
+// Reference lines do not count towards the chart's ranges unless
+// IncludeInRange is set, and are not drawn if their value is outside them.
+type ReferenceLineSeries struct {
+	Name   string
+	Style  Style
+	YAxis  YAxisType
+	ZOrder ZOrder
+
+	// Vertical draws the line at an x value rather than a y value.
+	Vertical bool
+	Value    float64
+	Label    string
+
+	// IncludeInRange counts the value towards the range of its axis, so the
+	// line is always drawn.
+	IncludeInRange bool
+}
+
+// GetName returns the name of the series.
+func (rls ReferenceLineSeries) GetName() string {
+	return rls.Name
+}
+
+// GetStyle returns the series style.
+func (rls ReferenceLineSeries) GetStyle() Style {
+	return rls.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rls ReferenceLineSeries) GetYAxis() YAxisType {
+	return rls.YAxis
+}
+
+// GetZOrder returns where the series draws relative to the others.
+func (rls ReferenceLineSeries) GetZOrder() ZOrder {
+	return rls.ZOrder
+}
+
+// Len returns 1 if the series counts towards the chart's ranges, and 0 otherwise.
+func (rls ReferenceLineSeries) Len() int {
+	return boolToLen(rls.IncludeInRange)
+}
+
+// GetValueBounds returns the value on the axis of the line; the other axis
+// is left unbounded.
+func (rls ReferenceLineSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	if rls.Vertical {
+		return rls.Value, rls.Value, math.MaxFloat64, -math.MaxFloat64
+	}
+	return math.MaxFloat64, -math.MaxFloat64, rls.Value, rls.Value
+}
+
+// Render draws the series.
+func (rls ReferenceLineSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := rls.Style.InheritFrom(referenceLineStyleDefaults(defaults))
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
+
+	if rls.Vertical {
+		x := sp.X(rls.Value)
+		if x < float64(canvasBox.Left) || x > float64(canvasBox.Right) {
+			return
+		}
+		style.GetStrokeOptions().WriteToRenderer(r)
+		sp.MoveTo(x, float64(canvasBox.Top))
+		sp.LineTo(x, float64(canvasBox.Bottom))
+		r.Stroke()
+		r.ResetStyle()
+
+		if len(rls.Label) > 0 {
+			// the label sits beside the line at the top of the canvas.
+			textBox := Draw.MeasureText(r, rls.Label, style)
+			Draw.Text(r, rls.Label, int(x)+DefaultReferenceLabelPadding, canvasBox.Top+DefaultReferenceLabelPadding+textBox.Height(), style)
+		}
+		return
+	}
+
+	y := sp.Y(rls.Value)
+	if y < float64(canvasBox.Top) || y > float64(canvasBox.Bottom) {
+		return
+	}
+	style.GetStrokeOptions().WriteToRenderer(r)
+	sp.MoveTo(float64(canvasBox.Left), y)
+	sp.LineTo(float64(canvasBox.Right), y)
+	r.Stroke()
+	r.ResetStyle()
+
+	if len(rls.Label) > 0 {
+		// the label sits above the line at the right of the canvas.
+		textBox := Draw.MeasureText(r, rls.Label, style)
+		Draw.Text(r, rls.Label, canvasBox.Right-DefaultReferenceLabelPadding-textBox.Width(), int(y)-DefaultReferenceLabelPadding, style)
+	}
+}
+
+// Validate validates the series.
+func (rls ReferenceLineSeries) Validate() error {
+	if math.IsNaN(rls.Value) || math.IsInf(rls.Value, 0) {
+		return fmt.Errorf("reference line series requires a finite value")
+	}
+	return nil
+}
+
+func referenceLineStyleDefaults(defaults Style) Style {
+	return Style{
+		StrokeColor:     defaults.StrokeColor,
+		StrokeWidth:     defaults.StrokeWidth,
+		StrokeDashArray: []float64{5.0, 5.0},
+		Font:            defaults.Font,
+		FontColor:       DefaultTextColor,
+		FontSize:        DefaultAnnotationFontSize,
+	}
+}
+
+// BandSeries is a labeled shaded band across the canvas between two y
+// values, such as the acceptable range of a metric.
+//
+// Bands do not count towards the chart's ranges unless IncludeInRange is
+// set, and are clipped to them.
+type BandSeries struct {
+	Name   string
+	Style  Style
+	YAxis  YAxisType
+	ZOrder ZOrder
+
+	From, To float64
+	Label    string
+
+	// IncludeInRange counts the band towards the y range, so it is drawn whole.
+	IncludeInRange bool
+}
+
+// GetName returns the name of the series.
+func (bs BandSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the series style.
+func (bs BandSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BandSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// GetZOrder returns where the series draws relative to the others.
+func (bs BandSeries) GetZOrder() ZOrder {
+	return bs.ZOrder
+}
+
+// Len returns 1 if the series counts towards the chart's ranges, and 0 otherwise.
+func (bs BandSeries) Len() int {
+	return boolToLen(bs.IncludeInRange)
+}
+
+// GetValueBounds returns the y values of the band; the x axis is left unbounded.
+func (bs BandSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	return math.MaxFloat64, -math.MaxFloat64, math.Min(bs.From, bs.To), math.Max(bs.From, bs.To)
+}
+
+// Render draws the series.
+func (bs BandSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
+	top, bottom := clampSpan(sp.Y(bs.From), sp.Y(bs.To), float64(canvasBox.Top), float64(canvasBox.Bottom))
+	if top >= bottom {
+		return
+	}
+
+	style := bs.Style.InheritFrom(referenceAreaStyleDefaults(defaults))
+	drawReferenceArea(r, sp, style, float64(canvasBox.Left), top, float64(canvasBox.Right), bottom)
+
+	if len(bs.Label) > 0 {
+		// the label sits inside the top left of the band.
+		textBox := Draw.MeasureText(r, bs.Label, style)
+		Draw.Text(r, bs.Label, canvasBox.Left+DefaultReferenceLabelPadding, int(top)+DefaultReferenceLabelPadding+textBox.Height(), style)
+	}
+}
+
+// Validate validates the series.
+func (bs BandSeries) Validate() error {
+	if !isFiniteSpan(bs.From, bs.To) {
+		return fmt.Errorf("band series requires finite from and to values")
+	}
+	return nil
+}
+
+// RegionSeries is a labeled shaded region of the canvas between two x
+// values, such as a maintenance window; for a time axis use TimeToFloat64.
+//
+// Regions do not count towards the chart's ranges unless IncludeInRange is
+// set, and are clipped to them.
+type RegionSeries struct {
+	Name   string
+	Style  Style
+	YAxis  YAxisType
+	ZOrder ZOrder
+
+	From, To float64
+	Label    string
+
+	// IncludeInRange counts the region towards the x range, so it is drawn whole.
+	IncludeInRange bool
+}
+
+// GetName returns the name of the series.
+func (rs RegionSeries) GetName() string {
+	return rs.Name
+}
+
+// GetStyle returns the series style.
+func (rs RegionSeries) GetStyle() Style {
+	return rs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rs RegionSeries) GetYAxis() YAxisType {
+	return rs.YAxis
+}
+
+// GetZOrder returns where the series draws relative to the others.
+func (rs RegionSeries) GetZOrder() ZOrder {
+	return rs.ZOrder
+}
+
+// Len returns 1 if the series counts towards the chart's ranges, and 0 otherwise.
+func (rs RegionSeries) Len() int {
+	return boolToLen(rs.IncludeInRange)
+}
+
+// GetValueBounds returns the x values of the region; the y axis is left unbounded.
+func (rs RegionSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	return math.Min(rs.From, rs.To), math.Max(rs.From, rs.To), math.MaxFloat64, -math.MaxFloat64
+}
+
+// Render draws the series.
+func (rs RegionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
+	left, right := clampSpan(sp.X(rs.From), sp.X(rs.To), float64(canvasBox.Left), float64(canvasBox.Right))
+	if left >= right {
+		return
+	}
+
+	style := rs.Style.InheritFrom(referenceAreaStyleDefaults(defaults))
+	drawReferenceArea(r, sp, style, left, float64(canvasBox.Top), right, float64(canvasBox.Bottom))
+
+	if len(rs.Label) > 0 {
+		// the label sits inside the top left of the region.
+		textBox := Draw.MeasureText(r, rs.Label, style)
+		Draw.Text(r, rs.Label, int(left)+DefaultReferenceLabelPadding, canvasBox.Top+DefaultReferenceLabelPadding+textBox.Height(), style)
+	}
+}
+
+// Validate validates the series.
+func (rs RegionSeries) Validate() error {
+	if !isFiniteSpan(rs.From, rs.To) {
+		return fmt.Errorf("region series requires finite from and to values")
+	}
+	return nil
+}
+
+func referenceAreaStyleDefaults(defaults Style) Style {
+	return Style{
+		FillColor: defaults.StrokeColor.WithAlpha(DefaultReferenceFillAlpha),
+		Font:      defaults.Font,
+		FontColor: DefaultTextColor,
+		FontSize:  DefaultAnnotationFontSize,
+	}
+}
+
+// drawReferenceArea fills a rectangle of the canvas.
+func drawReferenceArea(r Renderer, sp seriesPath, style Style, left, top, right, bottom float64) {
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	sp.MoveTo(left, top)
+	sp.LineTo(right, top)
+	sp.LineTo(right, bottom)
+	sp.LineTo(left, bottom)
+	r.Close()
+	r.FillStroke()
+}
+
+// clampSpan orders the ends of a span and clamps them between two bounds.
+func clampSpan(a, b, lower, upper float64) (from, to float64) {
+	from, to = math.Min(a, b), math.Max(a, b)
+	return math.Max(from, lower), math.Min(to, upper)
+}
+
+func isFiniteSpan(from, to float64) bool {
+	return !math.IsNaN(from) && !math.IsNaN(to) && !math.IsInf(from, 0) && !math.IsInf(to, 0)
+}
+
+// boolToLen returns the length of a series that has one value if it is counted.
+func boolToLen(counted bool) int {
+	if counted {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,175 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func renderReferenceSeries(t *testing.T, s Series) string {
+	t.Helper()
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	s.Render(r, Box{Right: 100, Bottom: 100}, xrange, yrange, Style{StrokeColor: ColorBlue, StrokeWidth: 1, Font: f})
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	return buffer.String()
+}
+
+func TestReferenceLineSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	svg := renderReferenceSeries(t, ReferenceLineSeries{Value: 8, Label: "target"})
+	testutil.AssertContains(t, svg, `d="M 0 20L 100 20"`)
+	testutil.AssertContains(t, svg, `stroke-dasharray="5.0, 5.0"`)
+	testutil.AssertContains(t, svg, ">target</text>")
+
+	svg = renderReferenceSeries(t, ReferenceLineSeries{Vertical: true, Value: 3, Label: "deploy"})
+	testutil.AssertContains(t, svg, `d="M 30 0L 30 100"`)
+	testutil.AssertContains(t, svg, `<text x="35"`)
+
+	// lines outside the ranges are not drawn.
+	svg = renderReferenceSeries(t, ReferenceLineSeries{Value: 12, Label: "target"})
+	testutil.AssertNotContains(t, svg, "<path")
+	testutil.AssertNotContains(t, svg, "<text")
+}
+
+func TestBandSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	svg := renderReferenceSeries(t, BandSeries{From: 6, To: 2, Label: "normal"})
+	testutil.AssertContains(t, svg, `d="M 0 40L 100 40L 100 80L 0 80Z"`)
+	testutil.AssertContains(t, svg, "fill:rgba(0,116,217,0.2)")
+	testutil.AssertContains(t, svg, ">normal</text>")
+
+	// bands are clipped to the canvas.
+	svg = renderReferenceSeries(t, BandSeries{From: 5, To: 20})
+	testutil.AssertContains(t, svg, `d="M 0 0L 100 0L 100 50L 0 50Z"`)
+
+	svg = renderReferenceSeries(t, BandSeries{From: 11, To: 20})
+	testutil.AssertNotContains(t, svg, "<path")
+}
+
+func TestRegionSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	svg := renderReferenceSeries(t, RegionSeries{From: 5, To: 7, Label: "maintenance", Style: Style{FillColor: ColorLightGray}})
+	testutil.AssertContains(t, svg, `d="M 50 0L 70 0L 70 100L 50 100Z"`)
+	testutil.AssertContains(t, svg, "fill:"+ColorLightGray.String())
+	testutil.AssertContains(t, svg, `<text x="55"`)
+
+	svg = renderReferenceSeries(t, RegionSeries{From: -5, To: 2})
+	testutil.AssertContains(t, svg, `d="M 0 0L 20 0L 20 100L 0 100Z"`)
+}
+
+func TestReferenceSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNil(t, ReferenceLineSeries{Value: 1}.Validate())
+	testutil.AssertNotNil(t, ReferenceLineSeries{Value: math.NaN()}.Validate())
+	testutil.AssertNil(t, BandSeries{From: 1, To: 2}.Validate())
+	testutil.AssertNotNil(t, BandSeries{From: 1, To: math.Inf(1)}.Validate())
+	testutil.AssertNil(t, RegionSeries{From: 1, To: 2}.Validate())
+	testutil.AssertNotNil(t, RegionSeries{From: math.NaN(), To: 2}.Validate())
+}
+
+func TestChartGetSeriesDrawOrder(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{},
+			ReferenceLineSeries{ZOrder: ZOrderFront},
+			BandSeries{ZOrder: ZOrderBehind},
+			RegionSeries{},
+			BandSeries{ZOrder: ZOrder(99)},
+			RegionSeries{ZOrder: ZOrderBehind},
+		},
+	}
+	testutil.AssertEqual(t, []int{2, 5, 0, 3, 4, 1}, c.getSeriesDrawOrder())
+}
+
+func TestChartRenderReferenceSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := TimeSeries{
+		Name:    "Latency",
+		XValues: []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour), start.Add(3 * time.Hour)},
+		YValues: []float64{100, 120, 90, 110},
+	}
+	c := Chart{
+		Series: []Series{
+			ts,
+			ReferenceLineSeries{Name: "SLO", Value: 115, Label: "SLO", ZOrder: ZOrderFront},
+			BandSeries{Name: "Normal", From: 95, To: 105, ZOrder: ZOrderBehind},
+			RegionSeries{
+				Name:   "Maintenance",
+				From:   TimeToFloat64(start.Add(time.Hour)),
+				To:     TimeToFloat64(start.Add(2 * time.Hour)),
+				Label:  "maintenance",
+				ZOrder: ZOrderBehind,
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	svg := buffer.String()
+	normal := strings.Index(svg, `id="chart-series-normal"`)
+	latency := strings.Index(svg, `id="chart-series-latency"`)
+	slo := strings.Index(svg, `id="chart-series-slo"`)
+	testutil.AssertTrue(t, normal >= 0 && latency >= 0 && slo >= 0)
+	testutil.AssertTrue(t, normal < latency)
+	testutil.AssertTrue(t, latency < slo)
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestChartGetRangesReferenceSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{10, 20, 15},
+	}
+	target := ReferenceLineSeries{Value: 50, Label: "Target"}
+	band := BandSeries{From: -10, To: 5}
+	region := RegionSeries{From: 4, To: 0}
+
+	// by default the references don't count towards the ranges.
+	c := Chart{Series: []Series{cs, target, band, region}}
+	xrange, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 1.0, xrange.GetMin())
+	testutil.AssertEqual(t, 3.0, xrange.GetMax())
+	testutil.AssertTrue(t, yrange.GetMax() < 50)
+	testutil.AssertTrue(t, yrange.GetMin() > -10)
+
+	target.IncludeInRange = true
+	band.IncludeInRange = true
+	region.IncludeInRange = true
+	c = Chart{Series: []Series{cs, target, band, region}}
+	xrange, yrange, _ = c.getRanges()
+	testutil.AssertEqual(t, 0.0, xrange.GetMin())
+	testutil.AssertEqual(t, 4.0, xrange.GetMax())
+	testutil.AssertTrue(t, yrange.GetMax() >= 50)
+	testutil.AssertTrue(t, yrange.GetMin() <= -10)
+
+	// a reference above the data is drawn once it counts.
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">Target</text>")
+}
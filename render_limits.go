@@ -0,0 +1,214 @@
+package chart
+
+import (
+	"fmt"
+)
+
+// RenderLimits are resource limits enforced while rendering a chart.
+// A zero value for any limit means it is not enforced.
+type RenderLimits struct {
+	// MaxSeriesPoints is the maximum number of values in any one series (or bars in a bar chart).
+	MaxSeriesPoints int
+	// MaxPathCommands is the maximum number of path commands (move, line, curves, close, circle, hit target) drawn in total.
+	MaxPathCommands int
+	// MaxWidth is the maximum canvas width in pixels.
+	MaxWidth int
+	// MaxHeight is the maximum canvas height in pixels.
+	MaxHeight int
+}
+
+// IsZero returns if no limits are set.
+func (rl RenderLimits) IsZero() bool {
+	return rl.MaxSeriesPoints == 0 &&
+		rl.MaxPathCommands == 0 &&
+		rl.MaxWidth == 0 &&
+		rl.MaxHeight == 0
+}
+
+// CheckCanvas returns a LimitError if the canvas size exceeds the limits.
+func (rl RenderLimits) CheckCanvas(width, height int) error {
+	if rl.MaxWidth > 0 && width > rl.MaxWidth {
+		return &LimitError{Limit: LimitWidth, Value: width, Max: rl.MaxWidth}
+	}
+	if rl.MaxHeight > 0 && height > rl.MaxHeight {
+		return &LimitError{Limit: LimitHeight, Value: height, Max: rl.MaxHeight}
+	}
+	return nil
+}
+
+// CheckSeriesPoints returns a LimitError if the number of points exceeds the limits.
+func (rl RenderLimits) CheckSeriesPoints(name string, points int) error {
+	if rl.MaxSeriesPoints > 0 && points > rl.MaxSeriesPoints {
+		return &LimitError{Limit: LimitSeriesPoints, Name: name, Value: points, Max: rl.MaxSeriesPoints}
+	}
+	return nil
+}
+
+// wrap returns a renderer that enforces the path command limit, if one is set.
+func (rl RenderLimits) wrap(r Renderer) (Renderer, *limitedRenderer) {
+	if rl.MaxPathCommands <= 0 {
+		return r, nil
+	}
+	lr := &limitedRenderer{Renderer: r, maxCommands: rl.MaxPathCommands}
+	return lr, lr
+}
+
+// Limit names reported by LimitError.
+const (
+	LimitSeriesPoints = "series points"
+	LimitPathCommands = "path commands"
+	LimitWidth        = "width"
+	LimitHeight       = "height"
+)
+
+// LimitError is returned when rendering a chart would exceed one of its RenderLimits.
+type LimitError struct {
+	// Limit is the name of the exceeded limit, e.g. LimitSeriesPoints.
+	Limit string
+	// Name is the name of the offending series, if any.
+	Name  string
+	Value int
+	Max   int
+}
+
+// Error implements error.
+func (le *LimitError) Error() string {
+	if len(le.Name) > 0 {
+		return fmt.Sprintf("render limit exceeded; series %q has %d %s, the maximum is %d", le.Name, le.Value, le.Limit, le.Max)
+	}
+	return fmt.Sprintf("render limit exceeded; %d %s, the maximum is %d", le.Value, le.Limit, le.Max)
+}
+
+// limitedRenderer is a renderer that stops drawing once it has been sent more
+// path commands than allowed.
+// The extension helpers unwrap it to reach the renderer it wraps, except for
+// the extensions that draw, which it counts.
+type limitedRenderer struct {
+	Renderer
+
+	maxCommands int
+	commands    int
+	err         error
+}
+
+// Err returns a LimitError if the path command limit has been exceeded.
+func (lr *limitedRenderer) Err() error {
+	if lr == nil {
+		return nil
+	}
+	return lr.err
+}
+
+// Unwrap returns the wrapped renderer.
+func (lr *limitedRenderer) Unwrap() Renderer {
+	return lr.Renderer
+}
+
+func (lr *limitedRenderer) count() bool {
+	lr.commands++
+	if lr.commands > lr.maxCommands {
+		if lr.err == nil {
+			lr.err = &LimitError{Limit: LimitPathCommands, Value: lr.commands, Max: lr.maxCommands}
+		}
+		return false
+	}
+	return true
+}
+
+// MoveTo implements the interface method.
+func (lr *limitedRenderer) MoveTo(x, y int) {
+	if lr.count() {
+		lr.Renderer.MoveTo(x, y)
+	}
+}
+
+// LineTo implements the interface method.
+func (lr *limitedRenderer) LineTo(x, y int) {
+	if lr.count() {
+		lr.Renderer.LineTo(x, y)
+	}
+}
+
+// QuadCurveTo implements the interface method.
+func (lr *limitedRenderer) QuadCurveTo(cx, cy, x, y int) {
+	if lr.count() {
+		lr.Renderer.QuadCurveTo(cx, cy, x, y)
+	}
+}
+
+// CubicCurveTo implements the interface method.
+func (lr *limitedRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	if lr.count() {
+		lr.Renderer.CubicCurveTo(cx1, cy1, cx2, cy2, x, y)
+	}
+}
+
+// Close implements the interface method.
+func (lr *limitedRenderer) Close() {
+	if lr.count() {
+		lr.Renderer.Close()
+	}
+}
+
+// Circle implements the interface method.
+func (lr *limitedRenderer) Circle(radius float64, x, y int) {
+	if lr.count() {
+		lr.Renderer.Circle(radius, x, y)
+	}
+}
+
+// HitTargetsEnabled forwards to the wrapped renderer if it is a HitTargetRenderer.
+func (lr *limitedRenderer) HitTargetsEnabled() bool {
+	_, ok := getHitTargetRenderer(lr.Renderer)
+	return ok
+}
+
+// HitTarget forwards to the wrapped renderer if it is a HitTargetRenderer.
+// Each target counts as a path command.
+func (lr *limitedRenderer) HitTarget(target HitTarget) {
+	if htr, ok := getHitTargetRenderer(lr.Renderer); ok && lr.count() {
+		htr.HitTarget(target)
+	}
+}
+
+// limitedRendererF counts the float precision path commands of a limited
+// renderer that wraps a RendererF.
+type limitedRendererF struct {
+	lr *limitedRenderer
+	rf RendererF
+}
+
+// MoveToF implements the RendererF interface method.
+func (lrf limitedRendererF) MoveToF(x, y float64) {
+	if lrf.lr.count() {
+		lrf.rf.MoveToF(x, y)
+	}
+}
+
+// LineToF implements the RendererF interface method.
+func (lrf limitedRendererF) LineToF(x, y float64) {
+	if lrf.lr.count() {
+		lrf.rf.LineToF(x, y)
+	}
+}
+
+// QuadCurveToF implements the RendererF interface method.
+func (lrf limitedRendererF) QuadCurveToF(cx, cy, x, y float64) {
+	if lrf.lr.count() {
+		lrf.rf.QuadCurveToF(cx, cy, x, y)
+	}
+}
+
+// CubicCurveToF implements the RendererF interface method.
+func (lrf limitedRendererF) CubicCurveToF(cx1, cy1, cx2, cy2, x, y float64) {
+	if lrf.lr.count() {
+		lrf.rf.CubicCurveToF(cx1, cy1, cx2, cy2, x, y)
+	}
+}
+
+// CircleF implements the RendererF interface method.
+func (lrf limitedRendererF) CircleF(radius, x, y float64) {
+	if lrf.lr.count() {
+		lrf.rf.CircleF(radius, x, y)
+	}
+}
@@ -0,0 +1,228 @@
+package chart
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testLimitsChart(limits RenderLimits) Chart {
+	return Chart{
+		Limits: limits,
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "Test",
+				XValues: LinearRange(1, 100),
+				YValues: LinearRange(1, 100),
+			},
+		},
+	}
+}
+
+func assertLimitError(t *testing.T, err error, limit string) {
+	t.Helper()
+	var le *LimitError
+	testutil.AssertTrue(t, errors.As(err, &le), "expected a limit error", err)
+	testutil.AssertEqual(t, limit, le.Limit)
+}
+
+func TestChartRenderContextCanceled(t *testing.T) {
+	// replaced new assertions helper
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := testLimitsChart(RenderLimits{})
+	buffer := bytes.NewBuffer([]byte{})
+	err := c.RenderContext(ctx, PNG, buffer)
+	testutil.AssertTrue(t, errors.Is(err, context.Canceled))
+	testutil.AssertZero(t, buffer.Len())
+}
+
+func TestChartRenderContextCanceledBetweenElements(t *testing.T) {
+	// replaced new assertions helper
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var rendered int
+	c := testLimitsChart(RenderLimits{})
+	c.Elements = []Renderable{
+		func(_ Renderer, _ Box, _ Style) {
+			rendered++
+			cancel()
+		},
+		func(_ Renderer, _ Box, _ Style) {
+			rendered++
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := c.RenderContext(ctx, SVG, buffer)
+	testutil.AssertTrue(t, errors.Is(err, context.Canceled))
+	testutil.AssertEqual(t, 1, rendered)
+}
+
+func TestChartRenderContextCanceledBetweenAxesPasses(t *testing.T) {
+	// replaced new assertions helper
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var formattedAfterCancel int
+	c := testLimitsChart(RenderLimits{})
+	c.XAxis.ValueFormatter = func(v interface{}) string {
+		if ctx.Err() != nil {
+			formattedAfterCancel++
+		}
+		return FloatValueFormatter(v)
+	}
+	c.YAxis.ValueFormatter = func(v interface{}) string {
+		cancel()
+		return FloatValueFormatter(v)
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := c.RenderContext(ctx, SVG, buffer)
+	testutil.AssertTrue(t, errors.Is(err, context.Canceled))
+	// the second pass of the axes is not started.
+	testutil.AssertZero(t, formattedAfterCancel)
+}
+
+// plainRenderer hides the optional extensions of the renderer it embeds.
+type plainRenderer struct {
+	Renderer
+}
+
+func TestChartRenderLimitsPlainRenderer(t *testing.T) {
+	// replaced new assertions helper
+
+	plain := func(width, height int) (Renderer, error) {
+		r, err := SVG(width, height)
+		return plainRenderer{r}, err
+	}
+
+	c := testLimitsChart(RenderLimits{MaxPathCommands: 10000})
+	c.Series[0] = ContinuousSeries{
+		XValues: LinearRange(1, 100),
+		YValues: LinearRange(1, 100),
+		Style:   Style{DotWidth: 2, Interpolation: InterpolationMonotoneCubic},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(plain, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+
+	// the extensions of the wrapped renderer are still reached.
+	c.Title = "Limited"
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "<title>Limited</title>")
+	testutil.AssertContains(t, buffer.String(), `role="group"`)
+}
+
+func TestChartRenderLimits(t *testing.T) {
+	// replaced new assertions helper
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	c := testLimitsChart(RenderLimits{MaxWidth: 640, MaxHeight: 480})
+	c.Width, c.Height = 1<<20, 400
+	assertLimitError(t, c.Render(PNG, buffer), LimitWidth)
+
+	c.Width, c.Height = 400, 1<<20
+	assertLimitError(t, c.Render(PNG, buffer), LimitHeight)
+
+	c = testLimitsChart(RenderLimits{MaxSeriesPoints: 50})
+	err := c.Render(PNG, buffer)
+	assertLimitError(t, err, LimitSeriesPoints)
+	testutil.AssertContains(t, err.Error(), "Test")
+
+	c = testLimitsChart(RenderLimits{MaxPathCommands: 50})
+	assertLimitError(t, c.Render(SVG, buffer), LimitPathCommands)
+	testutil.AssertZero(t, buffer.Len())
+
+	c = testLimitsChart(RenderLimits{
+		MaxSeriesPoints: 100,
+		MaxPathCommands: 10000,
+		MaxWidth:        1024,
+		MaxHeight:       1024,
+	})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestBarChartRenderLimits(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Limits: RenderLimits{MaxSeriesPoints: 2},
+		Bars: []Value{
+			{Value: 1, Label: "One"},
+			{Value: 2, Label: "Two"},
+			{Value: 3, Label: "Three"},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assertLimitError(t, bc.Render(PNG, buffer), LimitSeriesPoints)
+
+	bc.Limits = RenderLimits{MaxWidth: 100}
+	assertLimitError(t, bc.Render(PNG, buffer), LimitWidth)
+
+	bc.Limits = RenderLimits{MaxPathCommands: 5}
+	assertLimitError(t, bc.Render(PNG, buffer), LimitPathCommands)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bc.Limits = RenderLimits{}
+	testutil.AssertTrue(t, errors.Is(bc.RenderContext(ctx, PNG, buffer), context.Canceled))
+}
+
+// cancelingRenderer cancels a context once it has filled a number of shapes.
+type cancelingRenderer struct {
+	Renderer
+	cancel context.CancelFunc
+	after  int
+	filled *int
+}
+
+func (cr cancelingRenderer) FillStroke() {
+	cr.Renderer.FillStroke()
+	*cr.filled++
+	if *cr.filled == cr.after {
+		cr.cancel()
+	}
+}
+
+func TestBarChartRenderContextCanceledBetweenBars(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, horizontal := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// the background, the canvas and the first bar are filled.
+		var filled int
+		canceling := func(width, height int) (Renderer, error) {
+			r, err := SVG(width, height)
+			return cancelingRenderer{Renderer: r, cancel: cancel, after: 3, filled: &filled}, err
+		}
+
+		bc := BarChart{
+			IsHorizontal: horizontal,
+			Bars: []Value{
+				{Value: 1, Label: "One"},
+				{Value: 2, Label: "Two"},
+				{Value: 3, Label: "Three"},
+			},
+		}
+		buffer := bytes.NewBuffer([]byte{})
+		err := bc.RenderContext(ctx, canceling, buffer)
+		cancel()
+		testutil.AssertTrue(t, errors.Is(err, context.Canceled))
+		testutil.AssertEqual(t, 3, filled)
+		testutil.AssertZero(t, buffer.Len())
+	}
+}
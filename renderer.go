@@ -44,6 +44,10 @@ type Renderer interface {
 	// cx and cy represent the bezier "control points".
 	QuadCurveTo(cx, cy, x, y int)
 
+	// CubicCurveTo draws a cubic curve.
+	// cx1, cy1 and cx2, cy2 represent the bezier "control points".
+	CubicCurveTo(cx1, cy1, cx2, cy2, x, y int)
+
 	// Close finalizes a shape as drawn by LineTo.
 	Close()
 
@@ -83,3 +87,35 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// RendererF is an optional extension of Renderer that takes path coordinates
+// at float precision, so lines aren't snapped to whole pixels.
+// The series drawing helpers use it when the renderer supports it.
+type RendererF interface {
+	// MoveToF moves the cursor to a given point.
+	MoveToF(x, y float64)
+
+	// LineToF draws a line to a given point from the previous point.
+	LineToF(x, y float64)
+
+	// QuadCurveToF draws a quad curve.
+	QuadCurveToF(cx, cy, x, y float64)
+
+	// CubicCurveToF draws a cubic curve.
+	CubicCurveToF(cx1, cy1, cx2, cy2, x, y float64)
+
+	// CircleF draws a circle at the given coords with a given radius.
+	CircleF(radius, x, y float64)
+}
+
+// unwrapRenderer returns the renderer a wrapping renderer draws to, for the
+// optional extensions the wrapper does not implement itself.
+func unwrapRenderer(r Renderer) Renderer {
+	for {
+		wrapper, isWrapper := r.(interface{ Unwrap() Renderer })
+		if !isWrapper {
+			return r
+		}
+		r = wrapper.Unwrap()
+	}
+}
@@ -0,0 +1,215 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/userstyles-world/go-chart/v2/drawing"
+)
+
+const (
+	// DefaultScatterDotWidth is the default marker size for scatter series.
+	DefaultScatterDotWidth = 3.0
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*ScatterSeries)(nil)
+	_ FirstValuesProvider = (*ScatterSeries)(nil)
+	_ LastValuesProvider  = (*ScatterSeries)(nil)
+)
+
+// MarkerShape is an enum for the shapes points can be drawn with.
+type MarkerShape int
+
+const (
+	// MarkerShapeUnset is the unset state for marker shapes, it draws circles.
+	MarkerShapeUnset MarkerShape = 0
+	// MarkerShapeCircle draws points as circles.
+	MarkerShapeCircle MarkerShape = 1
+	// MarkerShapeSquare draws points as squares.
+	MarkerShapeSquare MarkerShape = 2
+	// MarkerShapeTriangle draws points as upward pointing triangles.
+	MarkerShapeTriangle MarkerShape = 3
+	// MarkerShapeDiamond draws points as diamonds.
+	MarkerShapeDiamond MarkerShape = 4
+	// MarkerShapeCross draws points as diagonal crosses; crosses are stroked only.
+	MarkerShapeCross MarkerShape = 5
+)
+
+// ScatterSeries draws points as unconnected markers.
+//
+// The marker size comes from Style.DotWidth or Style.DotWidthProvider, which
+// allows drawing bubble charts. The marker color comes from, in order of
+// priority, Style.DotColorProvider, ColorValues mapped through ColorProvider,
+// Style.DotColor, and the series color.
+// Markers have no outline unless a StrokeColor is set on the series Style.
+type ScatterSeries struct {
+	Name  string
+	Style Style
+
+	YAxis YAxisType
+
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
+	Marker MarkerShape
+
+	XValues []float64
+	YValues []float64
+
+	// ColorValues are values per point mapped to a color by ColorProvider.
+	ColorValues []float64
+	// ColorProvider maps ColorValues to colors, it defaults to DefaultColorScale.
+	ColorProvider ColorProvider
+}
+
+// DefaultColorScale is the default continuous color scale.
+var DefaultColorScale = ColorScale(ColorBlue, ColorGreen, ColorYellow)
+
+// GetName returns the name of the series.
+func (ss ScatterSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the series style.
+func (ss ScatterSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss ScatterSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// GetColorProvider returns the color provider or the default.
+func (ss ScatterSeries) GetColorProvider() ColorProvider {
+	if ss.ColorProvider == nil {
+		return DefaultColorScale
+	}
+	return ss.ColorProvider
+}
+
+// GetColorRange returns the min and max of the color values.
+func (ss ScatterSeries) GetColorRange() (min, max float64) {
+	if len(ss.ColorValues) == 0 {
+		return
+	}
+	return MinMax(ss.ColorValues...)
+}
+
+// Len returns the number of elements in the series.
+func (ss ScatterSeries) Len() int {
+	return len(ss.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (ss ScatterSeries) GetValues(index int) (x, y float64) {
+	return ss.XValues[index], ss.YValues[index]
+}
+
+// GetFirstValues gets the first x,y values.
+func (ss ScatterSeries) GetFirstValues() (x, y float64) {
+	return ss.XValues[0], ss.YValues[0]
+}
+
+// GetLastValues gets the last x,y values.
+func (ss ScatterSeries) GetLastValues() (x, y float64) {
+	return ss.XValues[len(ss.XValues)-1], ss.YValues[len(ss.YValues)-1]
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (ss ScatterSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if ss.XValueFormatter != nil {
+		x = ss.XValueFormatter
+	} else {
+		x = FloatValueFormatter
+	}
+	if ss.YValueFormatter != nil {
+		y = ss.YValueFormatter
+	} else {
+		y = FloatValueFormatter
+	}
+	return
+}
+
+// Render renders the series.
+func (ss ScatterSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if ss.Len() == 0 {
+		return
+	}
+
+	style := ss.Style.InheritFrom(defaults)
+	defaultColor := style.GetDotColor(style.GetStrokeColor())
+	defaultWidth := style.GetDotWidth(DefaultScatterDotWidth)
+	cmin, cmax := ss.GetColorRange()
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+
+	var vx, vy float64
+	var x, y int
+	for index := 0; index < ss.Len(); index++ {
+		vx, vy = ss.GetValues(index)
+		x = cl + xrange.Translate(vx)
+		y = cb - yrange.Translate(vy)
+
+		dotWidth := defaultWidth
+		if style.DotWidthProvider != nil {
+			dotWidth = style.DotWidthProvider(xrange, yrange, index, vx, vy)
+		}
+		if dotWidth <= 0 || math.IsNaN(dotWidth) {
+			continue
+		}
+
+		ss.getMarkerStyle(ss.getColor(style, xrange, yrange, index, vx, vy, cmin, cmax, defaultColor)).WriteDrawingOptionsToRenderer(r)
+		Draw.Marker(r, ss.Marker, x, y, dotWidth)
+	}
+}
+
+func (ss ScatterSeries) getColor(style Style, xrange, yrange Range, index int, vx, vy, cmin, cmax float64, defaultColor drawing.Color) drawing.Color {
+	if style.DotColorProvider != nil {
+		return style.DotColorProvider(xrange, yrange, index, vx, vy)
+	}
+	if index < len(ss.ColorValues) {
+		return ss.GetColorProvider()(ss.ColorValues[index], cmin, cmax)
+	}
+	return defaultColor
+}
+
+// getMarkerStyle returns the drawing style for a marker of a given color.
+func (ss ScatterSeries) getMarkerStyle(color drawing.Color) Style {
+	if ss.Marker == MarkerShapeCross {
+		return Style{
+			ClassName:   ss.Style.ClassName,
+			StrokeColor: color,
+			StrokeWidth: math.Max(1, ss.Style.StrokeWidth),
+		}
+	}
+	return Style{
+		ClassName:   ss.Style.ClassName,
+		FillColor:   color,
+		StrokeColor: ss.Style.GetStrokeColor(drawing.ColorTransparent),
+		StrokeWidth: ss.Style.StrokeWidth,
+	}
+}
+
+// Validate validates the series.
+func (ss ScatterSeries) Validate() error {
+	if len(ss.XValues) == 0 {
+		return fmt.Errorf("scatter series; must have xvalues set")
+	}
+
+	if len(ss.YValues) == 0 {
+		return fmt.Errorf("scatter series; must have yvalues set")
+	}
+
+	if len(ss.XValues) != len(ss.YValues) {
+		return fmt.Errorf("scatter series; must have same length xvalues as yvalues")
+	}
+
+	if len(ss.ColorValues) > 0 && len(ss.ColorValues) != len(ss.XValues) {
+		return fmt.Errorf("scatter series; must have same length colorvalues as xvalues")
+	}
+	return nil
+}
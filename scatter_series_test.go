@@ -0,0 +1,133 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/drawing"
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testScatterSeries(marker MarkerShape) ScatterSeries {
+	return ScatterSeries{
+		Name:        "Test",
+		Marker:      marker,
+		XValues:     []float64{1, 2, 3, 4, 5},
+		YValues:     []float64{5, 3, 4, 1, 2},
+		ColorValues: []float64{10, 20, 30, 40, 50},
+		Style: Style{
+			DotWidthProvider: func(_, _ Range, index int, _, _ float64) float64 {
+				return float64(index+1) * 4
+			},
+		},
+	}
+}
+
+func TestScatterSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, marker := range []MarkerShape{
+		MarkerShapeUnset,
+		MarkerShapeCircle,
+		MarkerShapeSquare,
+		MarkerShapeTriangle,
+		MarkerShapeDiamond,
+		MarkerShapeCross,
+	} {
+		ss := testScatterSeries(marker)
+		testutil.AssertNil(t, ss.Validate())
+
+		c := Chart{
+			Series: []Series{ss},
+		}
+		c.Elements = []Renderable{
+			LegendColorBar(ss.GetColorProvider(), 10, 50, nil),
+		}
+
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, c.Render(PNG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+
+		buffer.Reset()
+		testutil.AssertNil(t, c.Render(SVG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
+
+func TestLegendColorBarValueFormatter(t *testing.T) {
+	// replaced new assertions helper
+
+	ss := testScatterSeries(MarkerShapeCircle)
+	c := Chart{
+		Series: []Series{ss},
+	}
+
+	c.Elements = []Renderable{LegendColorBar(ss.GetColorProvider(), 10, 50, nil)}
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">50.00</text>")
+	testutil.AssertContains(t, buffer.String(), ">10.00</text>")
+
+	c.Elements = []Renderable{LegendColorBar(ss.GetColorProvider(), 10, 50, PercentValueFormatter)}
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">5000.00%</text>")
+	testutil.AssertContains(t, buffer.String(), ">1000.00%</text>")
+}
+
+func TestScatterSeriesNoStroke(t *testing.T) {
+	// replaced new assertions helper
+
+	ss := ScatterSeries{
+		XValues: []float64{1, 2},
+		YValues: []float64{1, 2},
+	}
+	c := Chart{
+		Series: []Series{ss},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	// the markers are filled with the series color and the line is not drawn.
+	testutil.AssertContains(t, buffer.String(), "fill:"+c.GetColorPalette().GetSeriesColor(0).String())
+	testutil.AssertNotContains(t, buffer.String(), "stroke:"+c.GetColorPalette().GetSeriesColor(0).String())
+}
+
+func TestScatterSeriesColorValues(t *testing.T) {
+	// replaced new assertions helper
+
+	ss := testScatterSeries(MarkerShapeCircle)
+	cmin, cmax := ss.GetColorRange()
+	testutil.AssertEqual(t, 10.0, cmin)
+	testutil.AssertEqual(t, 50.0, cmax)
+
+	defaultColor := drawing.ColorBlack
+	testutil.AssertEqual(t, ColorBlue, ss.getColor(Style{}, nil, nil, 0, 1, 5, cmin, cmax, defaultColor))
+	testutil.AssertEqual(t, ColorYellow, ss.getColor(Style{}, nil, nil, 4, 5, 2, cmin, cmax, defaultColor))
+
+	ss.ColorValues = nil
+	testutil.AssertEqual(t, defaultColor, ss.getColor(Style{}, nil, nil, 0, 1, 5, cmin, cmax, defaultColor))
+}
+
+func TestScatterSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ScatterSeries{}.Validate())
+	testutil.AssertNotNil(t, ScatterSeries{
+		XValues:     []float64{1, 2},
+		YValues:     []float64{1, 2},
+		ColorValues: []float64{1},
+	}.Validate())
+}
+
+func TestColorScale(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ColorScale(drawing.Color{R: 0, A: 255}, drawing.Color{R: 100, A: 255}, drawing.Color{R: 200, A: 255})
+	testutil.AssertEqual(t, uint8(0), cs(0, 0, 10).R)
+	testutil.AssertEqual(t, uint8(50), cs(2.5, 0, 10).R)
+	testutil.AssertEqual(t, uint8(100), cs(5, 0, 10).R)
+	testutil.AssertEqual(t, uint8(200), cs(10, 0, 10).R)
+	testutil.AssertEqual(t, uint8(200), cs(20, 0, 10).R)
+	testutil.AssertEqual(t, uint8(0), cs(-5, 0, 10).R)
+}
@@ -179,10 +179,10 @@ func (s Seq) Median() (median float64) {
 	sorted := s.Sort()
 	if l%2 == 0 {
 		v0 := sorted.GetValue(l/2 - 1)
-		v1 := sorted.GetValue(l/2 + 1)
+		v1 := sorted.GetValue(l / 2)
 		median = (v0 + v1) / 2
 	} else {
-		median = sorted.GetValue(l << 1)
+		median = sorted.GetValue(l / 2)
 	}
 
 	return
@@ -234,8 +234,9 @@ func (s Seq) StdDev() float64 {
 	return math.Pow(s.Variance(), 0.5)
 }
 
-// Percentile finds the relative standing in a slice of floats.
-// `percent` should be given on the interval [0,1.0).
+// Percentile finds the relative standing in a slice of floats, interpolating
+// linearly between the closest values.
+// `percent` should be given on the interval [0,1.0].
 func (s Seq) Percentile(percent float64) (percentile float64) {
 	l := s.Len()
 	if l == 0 {
@@ -243,21 +244,15 @@ func (s Seq) Percentile(percent float64) (percentile float64) {
 	}
 
 	if percent < 0 || percent > 1.0 {
-		panic("percent out of range [0.0, 1.0)")
+		panic("percent out of range [0.0, 1.0]")
 	}
 
 	sorted := s.Sort()
-	index := percent * float64(l)
-	i := f64i(index)
-	if index == float64(int64(index)) {
-		ci := sorted.GetValue(i - 1)
-		c := sorted.GetValue(i)
-		percentile = (ci + c) / 2.0
-	} else {
-		percentile = sorted.GetValue(i)
-	}
-
-	return percentile
+	position := percent * float64(l-1)
+	lower := int(math.Floor(position))
+	upper := MinInt(lower+1, l-1)
+	v0, v1 := sorted.GetValue(lower), sorted.GetValue(upper)
+	return v0 + (v1-v0)*(position-float64(lower))
 }
 
 // Normalize maps every value to the interval [0, 1.0].
@@ -75,6 +75,28 @@ func TestSeqAverage(t *testing.T) {
 	testutil.AssertEqual(t, 3, valuesOdd.Average())
 }
 
+func TestSeqMedian(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, 2.5, Seq{NewArray(4, 1, 3, 2)}.Median())
+	testutil.AssertEqual(t, 3, Seq{NewArray(5, 1, 4, 2, 3)}.Median())
+	testutil.AssertEqual(t, 0, Seq{NewArray()}.Median())
+}
+
+func TestSeqPercentile(t *testing.T) {
+	// replaced new assertions helper
+
+	values := Seq{NewArray(9, 1, 8, 2, 7, 3, 6, 4, 5)}
+	testutil.AssertEqual(t, 1, values.Percentile(0))
+	testutil.AssertEqual(t, 3, values.Percentile(0.25))
+	testutil.AssertEqual(t, 5, values.Percentile(0.5))
+	testutil.AssertEqual(t, 7, values.Percentile(0.75))
+	testutil.AssertEqual(t, 9, values.Percentile(1))
+	testutil.AssertEqual(t, 2.5, Seq{NewArray(1, 2, 3, 4)}.Percentile(0.5))
+	testutil.AssertEqual(t, 1.75, Seq{NewArray(1, 2, 3, 4)}.Percentile(0.25))
+	testutil.AssertEqual(t, 0, Seq{NewArray()}.Percentile(0.5))
+}
+
 func TestSequenceVariance(t *testing.T) {
 	// replaced new assertions helper
 
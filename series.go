@@ -8,3 +8,9 @@ type Series interface {
 	Validate() error
 	Render(r Renderer, canvasBox Box, xrange, yrange Range, s Style)
 }
+
+// innerSeriesProvider is a series drawn for another series, which takes the
+// style defaults of that series if it is in the same chart.
+type innerSeriesProvider interface {
+	getInnerSeries() interface{}
+}
@@ -0,0 +1,656 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// StackedBar is a bar within a StackedBarChart.
+// Values at the same index across bars represent the same metric and share a color.
+type StackedBar struct {
+	Name   string
+	Width  int
+	Values []Value
+}
+
+// GetWidth returns the width of the bar.
+func (sb StackedBar) GetWidth() int {
+	if sb.Width == 0 {
+		return DefaultBarWidth
+	}
+	return sb.Width
+}
+
+// Total returns the sum of the positive and negative segments of the bar.
+func (sb StackedBar) Total() (positive, negative float64) {
+	for _, v := range sb.Values {
+		if v.Value >= 0 {
+			positive += v.Value
+		} else {
+			negative += v.Value
+		}
+	}
+	return
+}
+
+// StackedBarMode is an enumeration of how the segments of a stacked bar are laid out.
+type StackedBarMode int
+
+const (
+	// StackedBarModeStacked draws the segments of a bar on top of each other.
+	StackedBarModeStacked StackedBarMode = 0
+	// StackedBarModeGrouped draws the segments of a bar side by side.
+	StackedBarModeGrouped StackedBarMode = 1
+)
+
+// StackedBarChart is a chart that draws bars made of multiple segments.
+type StackedBarChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	XAxis Style
+	YAxis YAxis
+
+	BarSpacing int
+
+	// IsHorizontal lays out the bars horizontally, with the bar names on the
+	// left axis and the values along the bottom.
+	IsHorizontal bool
+	Mode         StackedBarMode
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Bars     []StackedBar
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (sbc StackedBarChart) GetDPI(defaults ...float64) float64 {
+	if sbc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return sbc.DPI
+}
+
+// GetFont returns the text font.
+func (sbc StackedBarChart) GetFont() *truetype.Font {
+	if sbc.Font == nil {
+		return sbc.defaultFont
+	}
+	return sbc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (sbc StackedBarChart) GetWidth() int {
+	if sbc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return sbc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (sbc StackedBarChart) GetHeight() int {
+	if sbc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return sbc.Height
+}
+
+// GetBarSpacing returns the spacing between bars.
+func (sbc StackedBarChart) GetBarSpacing() int {
+	if sbc.BarSpacing == 0 {
+		return DefaultBarSpacing
+	}
+	return sbc.BarSpacing
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(sbc.Bars) == 0 {
+		return errors.New("please provide at least one bar")
+	}
+
+	r, err := rp(sbc.GetWidth(), sbc.GetHeight())
+	if err != nil {
+		return err
+	}
+	setAccessibleText(r, sbc.Title, sbc.getDescription())
+
+	if sbc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		sbc.defaultFont = defaultFont
+	}
+	r.SetDPI(sbc.GetDPI(DefaultDPI))
+
+	sbc.drawBackground(r)
+
+	var ticks []Tick
+	canvasBox := sbc.getDefaultCanvasBox()
+	vr := sbc.getRanges()
+	if vr.GetMax()-vr.GetMin() == 0 {
+		return fmt.Errorf("invalid data range; cannot be zero")
+	}
+	vr = sbc.setRangeDomains(canvasBox, vr)
+
+	if sbc.hasAxes() {
+		ticks = sbc.getAxesTicks(r, vr)
+		canvasBox = sbc.getAdjustedCanvasBox(r, canvasBox, vr, ticks)
+		vr = sbc.setRangeDomains(canvasBox, vr)
+	}
+
+	sbc.drawCanvas(r, canvasBox)
+	sbc.drawBars(r, canvasBox, vr)
+	sbc.drawCategoryAxis(r, canvasBox)
+	sbc.drawValueAxis(r, canvasBox, vr, ticks)
+
+	sbc.drawTitle(r)
+	for _, a := range sbc.Elements {
+		a(r, canvasBox, sbc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// getDescription returns a summary of the bars, for renderers that support it.
+func (sbc StackedBarChart) getDescription() string {
+	labels := make([]string, 0, len(sbc.Bars))
+	for _, item := range sbc.Bars {
+		labels = append(labels, item.Name)
+	}
+	return describeItems("Stacked bar chart", "bar", "bars", labels)
+}
+
+func (sbc StackedBarChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  sbc.GetWidth(),
+		Bottom: sbc.GetHeight(),
+	}, sbc.getBackgroundStyle())
+}
+
+func (sbc StackedBarChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, sbc.getCanvasStyle())
+}
+
+func (sbc StackedBarChart) getRanges() Range {
+	var vrange Range
+	if sbc.YAxis.Range != nil && !sbc.YAxis.Range.IsZero() {
+		vrange = sbc.YAxis.Range
+	} else {
+		vrange = &ContinuousRange{}
+	}
+
+	if !vrange.IsZero() {
+		return vrange
+	}
+
+	if len(sbc.YAxis.Ticks) > 0 {
+		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
+		for _, t := range sbc.YAxis.Ticks {
+			tickMin = math.Min(tickMin, t.Value)
+			tickMax = math.Max(tickMax, t.Value)
+		}
+		vrange.SetMin(tickMin)
+		vrange.SetMax(tickMax)
+		return vrange
+	}
+
+	var min, max float64
+	for _, b := range sbc.Bars {
+		if sbc.Mode == StackedBarModeGrouped {
+			for _, v := range b.Values {
+				min = math.Min(v.Value, min)
+				max = math.Max(v.Value, max)
+			}
+		} else {
+			positive, negative := b.Total()
+			min = math.Min(negative, min)
+			max = math.Max(positive, max)
+		}
+	}
+
+	vrange.SetMin(min)
+	vrange.SetMax(max)
+
+	if !sbc.YAxis.Style.Hidden {
+		roundTo := GetRoundToForDelta(vrange.GetDelta())
+		vrange.SetMin(RoundDown(vrange.GetMin(), roundTo))
+		vrange.SetMax(RoundUp(vrange.GetMax(), roundTo))
+	}
+
+	return vrange
+}
+
+func (sbc StackedBarChart) setRangeDomains(canvasBox Box, vr Range) Range {
+	if sbc.IsHorizontal {
+		vr.SetDomain(canvasBox.Width())
+	} else {
+		vr.SetDomain(canvasBox.Height())
+	}
+	return vr
+}
+
+func (sbc StackedBarChart) hasAxes() bool {
+	return !sbc.YAxis.Style.Hidden
+}
+
+func (sbc StackedBarChart) getValueFormatter() ValueFormatter {
+	if sbc.YAxis.ValueFormatter != nil {
+		return sbc.YAxis.ValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+func (sbc StackedBarChart) getAxesTicks(r Renderer, vr Range) []Tick {
+	if sbc.YAxis.Style.Hidden {
+		return nil
+	}
+	if sbc.IsHorizontal {
+		return valueAxisAsXAxis(sbc.YAxis).GetTicks(r, vr, sbc.styleDefaultsAxes(), sbc.getValueFormatter())
+	}
+	return sbc.YAxis.GetTicks(r, vr, sbc.styleDefaultsAxes(), sbc.getValueFormatter())
+}
+
+// getScaledBarDimensions returns the bar widths and spacing, scaled down if
+// the bars would not fit within the available pixels.
+func (sbc StackedBarChart) getScaledBarDimensions(available int) (widths []int, spacing int) {
+	spacing = sbc.GetBarSpacing()
+	total := 0
+	for _, bar := range sbc.Bars {
+		total += bar.GetWidth() + spacing
+	}
+
+	scale := 1.0
+	if total > available && total > 0 {
+		scale = float64(available) / float64(total)
+	}
+
+	widths = make([]int, len(sbc.Bars))
+	for index, bar := range sbc.Bars {
+		widths[index] = int(math.Floor(float64(bar.GetWidth()) * scale))
+	}
+	spacing = int(math.Floor(float64(spacing) * scale))
+	return
+}
+
+// getBarSlots returns the box each bar occupies along the category axis,
+// including its share of the spacing.
+func (sbc StackedBarChart) getBarSlots(canvasBox Box) []Box {
+	available := canvasBox.Width()
+	if sbc.IsHorizontal {
+		available = canvasBox.Height()
+	}
+	widths, spacing := sbc.getScaledBarDimensions(available)
+
+	slots := make([]Box, len(sbc.Bars))
+	cursor := 0
+	for index, width := range widths {
+		if sbc.IsHorizontal {
+			slots[index] = Box{
+				Top:    canvasBox.Top + cursor,
+				Left:   canvasBox.Left,
+				Right:  canvasBox.Right,
+				Bottom: canvasBox.Top + cursor + width + spacing,
+			}
+		} else {
+			slots[index] = Box{
+				Top:    canvasBox.Top,
+				Left:   canvasBox.Left + cursor,
+				Right:  canvasBox.Left + cursor + width + spacing,
+				Bottom: canvasBox.Bottom,
+			}
+		}
+		cursor += width + spacing
+	}
+	return slots
+}
+
+func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box, vr Range) {
+	available := canvasBox.Width()
+	if sbc.IsHorizontal {
+		available = canvasBox.Height()
+	}
+	widths, spacing := sbc.getScaledBarDimensions(available)
+	bs2 := spacing >> 1
+
+	for index, slot := range sbc.getBarSlots(canvasBox) {
+		bar := sbc.Bars[index]
+		if sbc.IsHorizontal {
+			sbc.drawBar(r, canvasBox, vr, bar, slot.Top+bs2, widths[index])
+		} else {
+			sbc.drawBar(r, canvasBox, vr, bar, slot.Left+bs2, widths[index])
+		}
+	}
+}
+
+// drawBar draws the segments of a bar starting at the given offset along the category axis.
+func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, vr Range, bar StackedBar, offset, width int) {
+	if len(bar.Values) == 0 {
+		return
+	}
+
+	// grouped bars grow from zero, or from the nearest edge of the range if it excludes zero.
+	base := math.Max(vr.GetMin(), math.Min(0, vr.GetMax()))
+
+	var positive, negative, from, to float64
+	subWidth := width / len(bar.Values)
+	for index, v := range bar.Values {
+		start, end := offset, offset+width
+		if sbc.Mode == StackedBarModeGrouped {
+			from, to = base, v.Value
+			start = offset + index*subWidth
+			end = start + subWidth
+		} else if v.Value >= 0 {
+			from, to = positive, positive+v.Value
+			positive += v.Value
+		} else {
+			from, to = negative, negative+v.Value
+			negative += v.Value
+		}
+
+		Draw.Box(r, sbc.getSegmentBox(canvasBox, vr, start, end, from, to), v.Style.InheritFrom(sbc.styleDefaultsSegment(index)))
+	}
+}
+
+// getSegmentBox maps a segment spanning [start,end] pixels along the category axis
+// and [from,to] along the value axis to a box on the canvas.
+func (sbc StackedBarChart) getSegmentBox(canvasBox Box, vr Range, start, end int, from, to float64) Box {
+	from = math.Max(vr.GetMin(), math.Min(vr.GetMax(), from))
+	to = math.Max(vr.GetMin(), math.Min(vr.GetMax(), to))
+
+	if sbc.IsHorizontal {
+		left := canvasBox.Left + vr.Translate(from)
+		right := canvasBox.Left + vr.Translate(to)
+		return Box{
+			Top:    start,
+			Left:   MinInt(left, right),
+			Right:  MaxInt(left, right),
+			Bottom: end,
+		}
+	}
+
+	bottom := canvasBox.Bottom - vr.Translate(from)
+	top := canvasBox.Bottom - vr.Translate(to)
+	return Box{
+		Top:    MinInt(top, bottom),
+		Left:   start,
+		Right:  end,
+		Bottom: MaxInt(top, bottom),
+	}
+}
+
+func (sbc StackedBarChart) drawCategoryAxis(r Renderer, canvasBox Box) {
+	if sbc.XAxis.Hidden {
+		return
+	}
+
+	axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
+	axisStyle.WriteToRenderer(r)
+
+	if sbc.IsHorizontal {
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+	} else {
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+	}
+
+	slots := sbc.getBarSlots(canvasBox)
+	for index, bar := range sbc.Bars {
+		labelBox := sbc.getCategoryLabelBox(canvasBox, slots[index])
+		if len(bar.Name) > 0 {
+			Draw.TextWithin(r, bar.Name, labelBox, sbc.getCategoryLabelStyle(axisStyle))
+		}
+
+		axisStyle.WriteToRenderer(r)
+		if index < len(sbc.Bars)-1 {
+			if sbc.IsHorizontal {
+				r.MoveTo(canvasBox.Left, slots[index].Bottom)
+				r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, slots[index].Bottom)
+			} else {
+				r.MoveTo(slots[index].Right, canvasBox.Bottom)
+				r.LineTo(slots[index].Right, canvasBox.Bottom+DefaultVerticalTickHeight)
+			}
+			r.Stroke()
+		}
+	}
+}
+
+// getCategoryLabelBox returns the box a bar's name is drawn within.
+func (sbc StackedBarChart) getCategoryLabelBox(canvasBox, slot Box) Box {
+	if sbc.IsHorizontal {
+		return Box{
+			Top:    slot.Top,
+			Left:   sbc.box().Left,
+			Right:  canvasBox.Left - DefaultYAxisMargin,
+			Bottom: slot.Bottom,
+		}
+	}
+	return Box{
+		Top:    canvasBox.Bottom + DefaultXAxisMargin,
+		Left:   slot.Left,
+		Right:  slot.Right,
+		Bottom: sbc.GetHeight(),
+	}
+}
+
+func (sbc StackedBarChart) getCategoryLabelStyle(axisStyle Style) Style {
+	if sbc.IsHorizontal {
+		return sbc.XAxis.InheritFrom(Style{
+			TextHorizontalAlign: TextHorizontalAlignRight,
+			TextVerticalAlign:   TextVerticalAlignMiddle,
+		}).InheritFrom(axisStyle)
+	}
+	return axisStyle
+}
+
+func (sbc StackedBarChart) drawValueAxis(r Renderer, canvasBox Box, vr Range, ticks []Tick) {
+	if sbc.YAxis.Style.Hidden {
+		return
+	}
+	if sbc.IsHorizontal {
+		valueAxisAsXAxis(sbc.YAxis).Render(r, canvasBox, vr, sbc.styleDefaultsAxes(), ticks)
+		return
+	}
+	sbc.YAxis.Render(r, canvasBox, vr, sbc.styleDefaultsAxes(), ticks)
+}
+
+func (sbc StackedBarChart) drawTitle(r Renderer) {
+	if len(sbc.Title) > 0 && !sbc.TitleStyle.Hidden {
+		Draw.TextWithin(r, sbc.Title, sbc.box(), sbc.styleDefaultsTitle())
+	}
+}
+
+func (sbc StackedBarChart) getDefaultCanvasBox() Box {
+	return sbc.box()
+}
+
+func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, vr Range, ticks []Tick) Box {
+	axesOuterBox := canvasBox.Clone()
+
+	if !sbc.XAxis.Hidden {
+		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		if sbc.IsHorizontal {
+			var labelWidth int
+			for _, bar := range sbc.Bars {
+				if len(bar.Name) > 0 {
+					labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, bar.Name, axisStyle).Width())
+				}
+			}
+			axesOuterBox = axesOuterBox.Grow(Box{
+				Top:    canvasBox.Top,
+				Left:   canvasBox.Left - (labelWidth + 1 + DefaultYAxisMargin), // text wraps when it exactly fills its box.
+				Right:  canvasBox.Right,
+				Bottom: canvasBox.Bottom,
+			})
+		} else {
+			xaxisHeight := DefaultVerticalTickHeight
+			slots := sbc.getBarSlots(canvasBox)
+			for index, bar := range sbc.Bars {
+				if len(bar.Name) > 0 {
+					labelBox := sbc.getCategoryLabelBox(canvasBox, slots[index])
+					lines := Text.WrapFit(r, bar.Name, labelBox.Width(), axisStyle)
+					linesBox := Text.MeasureLines(r, lines, axisStyle)
+					xaxisHeight = MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+				}
+			}
+			axesOuterBox = axesOuterBox.Grow(Box{
+				Top:    canvasBox.Top,
+				Left:   canvasBox.Left,
+				Right:  canvasBox.Right,
+				Bottom: canvasBox.Bottom + xaxisHeight,
+			})
+		}
+	}
+
+	if !sbc.YAxis.Style.Hidden {
+		var axesBounds Box
+		if sbc.IsHorizontal {
+			axesBounds = valueAxisAsXAxis(sbc.YAxis).Measure(r, canvasBox, vr, sbc.styleDefaultsAxes(), ticks)
+		} else {
+			axesBounds = sbc.YAxis.Measure(r, canvasBox, vr, sbc.styleDefaultsAxes(), ticks)
+		}
+		axesOuterBox = axesOuterBox.Grow(axesBounds)
+	}
+
+	return canvasBox.OuterConstrain(sbc.box(), axesOuterBox)
+}
+
+// box returns the chart bounds as a box.
+func (sbc StackedBarChart) box() Box {
+	dpr := sbc.Background.Padding.GetRight(10)
+	dpb := sbc.Background.Padding.GetBottom(50)
+
+	return Box{
+		Top:    sbc.Background.Padding.GetTop(20),
+		Left:   sbc.Background.Padding.GetLeft(20),
+		Right:  sbc.GetWidth() - dpr,
+		Bottom: sbc.GetHeight() - dpb,
+	}
+}
+
+func (sbc StackedBarChart) getBackgroundStyle() Style {
+	return sbc.Background.InheritFrom(sbc.styleDefaultsBackground())
+}
+
+func (sbc StackedBarChart) getCanvasStyle() Style {
+	return sbc.Canvas.InheritFrom(sbc.styleDefaultsCanvas())
+}
+
+func (sbc StackedBarChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   sbc.GetColorPalette().BackgroundColor(),
+		StrokeColor: sbc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   sbc.GetColorPalette().CanvasColor(),
+		StrokeColor: sbc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultCanvasStrokeWidth,
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsSegment(index int) Style {
+	return Style{
+		StrokeColor: sbc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   sbc.GetColorPalette().GetSeriesColor(index),
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsTitle() Style {
+	return sbc.TitleStyle.InheritFrom(Style{
+		FontColor:           sbc.GetColorPalette().TextColor(),
+		Font:                sbc.GetFont(),
+		FontSize:            sbc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (sbc StackedBarChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(sbc.GetWidth(), sbc.GetHeight())
+	switch {
+	case effectiveDimension >= 2048:
+		return 48
+	case effectiveDimension >= 1024:
+		return 24
+	case effectiveDimension >= 512:
+		return 18
+	case effectiveDimension >= 256:
+		return 12
+	default:
+		return 10
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsAxes() Style {
+	return Style{
+		StrokeColor:         sbc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth:         DefaultAxisLineWidth,
+		Font:                sbc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           sbc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsElements() Style {
+	return Style{
+		Font: sbc.GetFont(),
+	}
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (sbc StackedBarChart) GetColorPalette() ColorPalette {
+	if sbc.ColorPalette != nil {
+		return sbc.ColorPalette
+	}
+	return AlternateColorPalette
+}
+
+// valueAxisAsXAxis returns an x-axis that draws a value axis along the bottom of
+// the canvas, as used by horizontal bar charts.
+func valueAxisAsXAxis(ya YAxis) XAxis {
+	return XAxis{
+		Name:           ya.Name,
+		NameStyle:      ya.NameStyle,
+		Style:          ya.Style,
+		ValueFormatter: ya.ValueFormatter,
+		Range:          ya.Range,
+		TickStyle:      ya.TickStyle,
+		Ticks:          ya.Ticks,
+		GridLines:      ya.GridLines,
+		GridMajorStyle: ya.GridMajorStyle,
+		GridMinorStyle: ya.GridMinorStyle,
+	}
+}
@@ -0,0 +1,101 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testStackedBars() []StackedBar {
+	return []StackedBar{
+		{Name: "One", Values: []Value{{Value: 1, Label: "Installs"}, {Value: 2, Label: "Updates"}}},
+		{Name: "Two", Values: []Value{{Value: 3}, {Value: 4}}},
+		{Name: "Three", Values: []Value{{Value: 5}, {Value: -1}}},
+	}
+}
+
+func TestStackedBarTotal(t *testing.T) {
+	// replaced new assertions helper
+
+	positive, negative := StackedBar{Values: []Value{{Value: 1}, {Value: -2}, {Value: 3}}}.Total()
+	testutil.AssertEqual(t, 4.0, positive)
+	testutil.AssertEqual(t, -2.0, negative)
+}
+
+func TestStackedBarChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, mode := range []StackedBarMode{StackedBarModeStacked, StackedBarModeGrouped} {
+		for _, isHorizontal := range []bool{false, true} {
+			sbc := StackedBarChart{
+				Title:        "Test Title",
+				Mode:         mode,
+				IsHorizontal: isHorizontal,
+				Bars:         testStackedBars(),
+			}
+			sbc.Elements = []Renderable{LegendStackedBar(&sbc)}
+
+			buf := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, sbc.Render(PNG, buf))
+			testutil.AssertNotZero(t, buf.Len())
+
+			buf.Reset()
+			testutil.AssertNil(t, sbc.Render(SVG, buf))
+			testutil.AssertNotZero(t, buf.Len())
+		}
+	}
+}
+
+func TestStackedBarChartRenderNoBars(t *testing.T) {
+	// replaced new assertions helper
+
+	sbc := StackedBarChart{}
+	testutil.AssertNotNil(t, sbc.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestStackedBarChartRenderZero(t *testing.T) {
+	// replaced new assertions helper
+
+	sbc := StackedBarChart{
+		Bars: []StackedBar{
+			{Name: "One", Values: []Value{{Value: 0}}},
+		},
+	}
+	testutil.AssertNotNil(t, sbc.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestStackedBarChartGetRanges(t *testing.T) {
+	// replaced new assertions helper
+
+	sbc := StackedBarChart{
+		YAxis: YAxis{Style: Hidden()},
+		Bars:  testStackedBars(),
+	}
+
+	vr := sbc.getRanges()
+	testutil.AssertEqual(t, -1.0, vr.GetMin())
+	testutil.AssertEqual(t, 7.0, vr.GetMax())
+
+	sbc.Mode = StackedBarModeGrouped
+	vr = sbc.getRanges()
+	testutil.AssertEqual(t, -1.0, vr.GetMin())
+	testutil.AssertEqual(t, 5.0, vr.GetMax())
+}
+
+func TestStackedBarChartGetScaledBarDimensions(t *testing.T) {
+	// replaced new assertions helper
+
+	sbc := StackedBarChart{
+		BarSpacing: 10,
+		Bars:       testStackedBars(),
+	}
+
+	widths, spacing := sbc.getScaledBarDimensions(1024)
+	testutil.AssertEqual(t, 10, spacing)
+	testutil.AssertEqual(t, []int{DefaultBarWidth, DefaultBarWidth, DefaultBarWidth}, widths)
+
+	widths, spacing = sbc.getScaledBarDimensions(90)
+	testutil.AssertEqual(t, 5, spacing)
+	testutil.AssertEqual(t, []int{25, 25, 25}, widths)
+}
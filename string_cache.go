@@ -0,0 +1,52 @@
+package chart
+
+import "sync"
+
+const (
+	// DefaultStringCacheCapacity is the number of entries a formatting cache
+	// holds before it is cleared.
+	DefaultStringCacheCapacity = 4096
+)
+
+// stringCache is a synchronized cache of formatted values.
+// It is cleared once it reaches its capacity so long running processes that
+// format many distinct values don't grow it without bound.
+type stringCache struct {
+	sync.RWMutex
+	capacity int
+	values   map[interface{}]string
+}
+
+func newStringCache(capacity int) *stringCache {
+	return &stringCache{
+		capacity: capacity,
+		values:   make(map[interface{}]string),
+	}
+}
+
+// GetOrAdd returns the cached value for a key, or formats and caches it.
+func (sc *stringCache) GetOrAdd(key interface{}, format func() string) string {
+	sc.RLock()
+	value, ok := sc.values[key]
+	sc.RUnlock()
+	if ok {
+		return value
+	}
+
+	value = format()
+
+	sc.Lock()
+	if len(sc.values) >= sc.capacity {
+		sc.values = make(map[interface{}]string)
+	}
+	sc.values[key] = value
+	sc.Unlock()
+	return value
+}
+
+// Len returns the number of cached values.
+func (sc *stringCache) Len() int {
+	sc.RLock()
+	defer sc.RUnlock()
+	return len(sc.values)
+}
@@ -52,6 +52,9 @@ type Style struct {
 	StrokeColor     drawing.Color
 	StrokeDashArray []float64
 
+	// Interpolation is how lines are drawn between the points of a series.
+	Interpolation Interpolation
+
 	DotColor drawing.Color
 	DotWidth float64
 
@@ -59,6 +62,11 @@ type Style struct {
 	DotColorProvider DotColorProvider
 
 	FillColor drawing.Color
+	// FillGradient is used instead of the fill color by renderers that support it.
+	FillGradient *drawing.Gradient
+	// FillPattern is drawn over the fill color by renderers that support it,
+	// and takes precedence over the fill gradient.
+	FillPattern *drawing.Pattern
 
 	FontSize  float64
 	FontColor drawing.Color
@@ -205,6 +213,28 @@ func (s Style) GetFillColor(defaults ...drawing.Color) drawing.Color {
 	return s.FillColor
 }
 
+// GetFillGradient returns the fill gradient.
+func (s Style) GetFillGradient(defaults ...*drawing.Gradient) *drawing.Gradient {
+	if s.FillGradient == nil {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return nil
+	}
+	return s.FillGradient
+}
+
+// GetFillPattern returns the fill pattern.
+func (s Style) GetFillPattern(defaults ...*drawing.Pattern) *drawing.Pattern {
+	if s.FillPattern == nil {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return nil
+	}
+	return s.FillPattern
+}
+
 // GetDotColor returns the stroke color.
 func (s Style) GetDotColor(defaults ...drawing.Color) drawing.Color {
 	if s.DotColor.IsZero() {
@@ -326,6 +356,17 @@ func (s Style) GetTextWrap(defaults ...TextWrap) TextWrap {
 	return s.TextWrap
 }
 
+// GetInterpolation returns how lines are drawn between points.
+func (s Style) GetInterpolation(defaults ...Interpolation) Interpolation {
+	if s.Interpolation == InterpolationUnset {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return InterpolationUnset
+	}
+	return s.Interpolation
+}
+
 // GetTextLineSpacing returns the spacing in pixels between lines of text (vertically).
 func (s Style) GetTextLineSpacing(defaults ...int) int {
 	if s.TextLineSpacing == 0 {
@@ -354,6 +395,12 @@ func (s Style) WriteToRenderer(r Renderer) {
 	r.SetStrokeWidth(s.GetStrokeWidth())
 	r.SetStrokeDashArray(s.GetStrokeDashArray())
 	r.SetFillColor(s.GetFillColor())
+	if gradient := s.GetFillGradient(); gradient != nil {
+		setFillGradient(r, gradient)
+	}
+	if pattern := s.GetFillPattern(); pattern != nil {
+		setFillPattern(r, pattern)
+	}
 	r.SetFont(s.GetFont())
 	r.SetFontColor(s.GetFontColor())
 	r.SetFontSize(s.GetFontSize())
@@ -371,6 +418,12 @@ func (s Style) WriteDrawingOptionsToRenderer(r Renderer) {
 	r.SetStrokeWidth(s.GetStrokeWidth())
 	r.SetStrokeDashArray(s.GetStrokeDashArray())
 	r.SetFillColor(s.GetFillColor())
+	if gradient := s.GetFillGradient(); gradient != nil {
+		setFillGradient(r, gradient)
+	}
+	if pattern := s.GetFillPattern(); pattern != nil {
+		setFillPattern(r, pattern)
+	}
 }
 
 // WriteTextOptionsToRenderer passes just the text style options to a renderer.
@@ -388,6 +441,7 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 	final.StrokeColor = s.GetStrokeColor(defaults.StrokeColor)
 	final.StrokeWidth = s.GetStrokeWidth(defaults.StrokeWidth)
 	final.StrokeDashArray = s.GetStrokeDashArray(defaults.StrokeDashArray)
+	final.Interpolation = s.GetInterpolation(defaults.Interpolation)
 
 	final.DotColor = s.GetDotColor(defaults.DotColor)
 	final.DotWidth = s.GetDotWidth(defaults.DotWidth)
@@ -396,6 +450,8 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 	final.DotColorProvider = s.DotColorProvider
 
 	final.FillColor = s.GetFillColor(defaults.FillColor)
+	final.FillGradient = s.GetFillGradient(defaults.FillGradient)
+	final.FillPattern = s.GetFillPattern(defaults.FillPattern)
 	final.FontColor = s.GetFontColor(defaults.FontColor)
 	final.FontSize = s.GetFontSize(defaults.FontSize)
 	final.Font = s.GetFont(defaults.Font)
@@ -422,8 +478,10 @@ func (s Style) GetStrokeOptions() Style {
 // GetFillOptions returns the fill components.
 func (s Style) GetFillOptions() Style {
 	return Style{
-		ClassName: s.ClassName,
-		FillColor: s.FillColor,
+		ClassName:    s.ClassName,
+		FillColor:    s.FillColor,
+		FillGradient: s.FillGradient,
+		FillPattern:  s.FillPattern,
 	}
 }
 
@@ -444,6 +502,8 @@ func (s Style) GetFillAndStrokeOptions() Style {
 		ClassName:       s.ClassName,
 		StrokeDashArray: s.StrokeDashArray,
 		FillColor:       s.FillColor,
+		FillGradient:    s.FillGradient,
+		FillPattern:     s.FillPattern,
 		StrokeColor:     s.StrokeColor,
 		StrokeWidth:     s.StrokeWidth,
 	}
@@ -476,5 +536,5 @@ func (s Style) ShouldDrawDot() bool {
 
 // ShouldDrawFill tells drawing functions if they should draw the stroke.
 func (s Style) ShouldDrawFill() bool {
-	return !s.FillColor.IsZero()
+	return !s.FillColor.IsZero() || s.FillGradient != nil || s.FillPattern != nil
 }
@@ -0,0 +1,287 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*TimeRange)(nil)
+	_ TicksProvider = (*TimeRange)(nil)
+	_ RangeF        = (*TimeRange)(nil)
+)
+
+// TimeRange is a continuous range over timestamps (as produced by TimeToFloat64)
+// that generates ticks aligned to calendar units.
+type TimeRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Location is the time zone ticks are aligned and formatted in, it defaults to time.Local.
+	Location *time.Location
+	// ValueFormatter overrides the formatter picked for the chosen tick unit.
+	ValueFormatter ValueFormatter
+}
+
+// GetLocation returns the location or the default.
+func (r TimeRange) GetLocation() *time.Location {
+	if r.Location == nil {
+		return time.Local
+	}
+	return r.Location
+}
+
+// IsDescending returns if the range is descending.
+func (r TimeRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the TimeRange has been set or not.
+func (r TimeRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetMin gets the min value for the time range.
+func (r TimeRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the time range.
+func (r *TimeRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the time range.
+func (r TimeRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the time range.
+func (r *TimeRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r TimeRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r TimeRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *TimeRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// String returns a simple string for the TimeRange.
+func (r TimeRange) String() string {
+	if r.GetDelta() == 0 {
+		return "TimeRange [empty]"
+	}
+	loc := r.GetLocation()
+	return fmt.Sprintf("TimeRange [%s,%s] => %d",
+		TimeFromFloat64(r.Min).In(loc).Format(time.RFC3339),
+		TimeFromFloat64(r.Max).In(loc).Format(time.RFC3339),
+		r.Domain,
+	)
+}
+
+// Translate maps a given value into the TimeRange space.
+func (r TimeRange) Translate(value float64) int {
+	normalized := value - r.Min
+	ratio := normalized / r.GetDelta()
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateF maps a given value into the TimeRange space without rounding.
+func (r TimeRange) TranslateF(value float64) float64 {
+	ratio := (value - r.Min) / r.GetDelta()
+
+	if r.IsDescending() {
+		return float64(r.Domain) - ratio*float64(r.Domain)
+	}
+	return ratio * float64(r.Domain)
+}
+
+// GetTicks returns ticks aligned to the smallest calendar unit (seconds through
+// years) whose labels fit the domain horizontally.
+// The labels are formatted to match the unit unless ValueFormatter is set;
+// the given formatter is only used if the span is too short for any unit.
+func (r TimeRange) GetTicks(re Renderer, defaults Style, vf ValueFormatter) []Tick {
+	span := time.Duration(math.Abs(r.Max - r.Min))
+	if span < time.Second || r.Domain <= 0 {
+		return GenerateContinuousTicks(re, &r, false, defaults, vf)
+	}
+
+	loc := r.GetLocation()
+	min := TimeFromFloat64(math.Min(r.Min, r.Max)).In(loc)
+	max := TimeFromFloat64(math.Max(r.Min, r.Max)).In(loc)
+
+	unit := r.getTickUnit(re, defaults, min, span)
+	format := r.ValueFormatter
+	if format == nil {
+		format = TimeValueFormatterWithFormat(unit.format)
+	}
+
+	var ticks []Tick
+	for t := unit.align(min); !t.After(max); t = unit.next(t) {
+		if t.Before(min) {
+			continue
+		}
+		ticks = append(ticks, Tick{
+			Value: TimeToFloat64(t),
+			Label: format(t.In(loc)),
+		})
+		if len(ticks) > DefaultTickCountSanityCheck {
+			break
+		}
+	}
+
+	if r.IsDescending() {
+		for i, j := 0, len(ticks)-1; i < j; i, j = i+1, j-1 {
+			ticks[i], ticks[j] = ticks[j], ticks[i]
+		}
+	}
+	return ticks
+}
+
+// getTickUnit returns the smallest tick unit whose labels fit within the domain.
+func (r TimeRange) getTickUnit(re Renderer, defaults Style, sample time.Time, span time.Duration) timeTickUnit {
+	defaults.GetTextOptions().WriteToRenderer(re)
+	for _, unit := range timeTickUnits {
+		label := sample.Format(unit.format)
+		if r.ValueFormatter != nil {
+			label = r.ValueFormatter(sample)
+		}
+		tickSize := re.MeasureText(label).Width() + DefaultMinimumTickHorizontalSpacing
+		maxTicks := float64(r.Domain) / float64(tickSize)
+		if float64(span)/float64(unit.approx) <= maxTicks {
+			return unit
+		}
+	}
+	return timeTickUnits[len(timeTickUnits)-1]
+}
+
+type timeTickUnitKind int
+
+const (
+	timeTickUnitSecond timeTickUnitKind = iota
+	timeTickUnitMinute
+	timeTickUnitHour
+	timeTickUnitDay
+	timeTickUnitWeek
+	timeTickUnitMonth
+	timeTickUnitYear
+)
+
+// timeTickUnit is a calendar interval ticks can be aligned to.
+type timeTickUnit struct {
+	kind   timeTickUnitKind
+	step   int
+	approx time.Duration
+	format string
+}
+
+const (
+	_day   = 24 * time.Hour
+	_month = 30 * _day
+	_year  = 365 * _day
+)
+
+// timeTickUnits are the candidate tick units ordered from smallest to largest.
+var timeTickUnits = []timeTickUnit{
+	{timeTickUnitSecond, 1, time.Second, DefaultDateSecondFormat},
+	{timeTickUnitSecond, 5, 5 * time.Second, DefaultDateSecondFormat},
+	{timeTickUnitSecond, 15, 15 * time.Second, DefaultDateSecondFormat},
+	{timeTickUnitSecond, 30, 30 * time.Second, DefaultDateSecondFormat},
+	{timeTickUnitMinute, 1, time.Minute, DefaultDateMinuteFormat},
+	{timeTickUnitMinute, 5, 5 * time.Minute, DefaultDateMinuteFormat},
+	{timeTickUnitMinute, 15, 15 * time.Minute, DefaultDateMinuteFormat},
+	{timeTickUnitMinute, 30, 30 * time.Minute, DefaultDateMinuteFormat},
+	{timeTickUnitHour, 1, time.Hour, DefaultDateHourFormat},
+	{timeTickUnitHour, 3, 3 * time.Hour, DefaultDateHourFormat},
+	{timeTickUnitHour, 6, 6 * time.Hour, DefaultDateHourFormat},
+	{timeTickUnitHour, 12, 12 * time.Hour, DefaultDateHourFormat},
+	{timeTickUnitDay, 1, _day, DefaultDateFormat},
+	{timeTickUnitWeek, 1, 7 * _day, DefaultDateFormat},
+	{timeTickUnitMonth, 1, _month, DefaultDateMonthFormat},
+	{timeTickUnitMonth, 3, 3 * _month, DefaultDateMonthFormat},
+	{timeTickUnitMonth, 6, 6 * _month, DefaultDateMonthFormat},
+	{timeTickUnitYear, 1, _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 2, 2 * _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 5, 5 * _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 10, 10 * _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 25, 25 * _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 50, 50 * _year, DefaultDateYearFormat},
+	{timeTickUnitYear, 100, 100 * _year, DefaultDateYearFormat},
+}
+
+// align returns the latest unit boundary at or before t, in t's location.
+func (u timeTickUnit) align(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+
+	switch u.kind {
+	case timeTickUnitSecond:
+		return time.Date(year, month, day, hour, minute, second-second%u.step, 0, loc)
+	case timeTickUnitMinute:
+		return time.Date(year, month, day, hour, minute-minute%u.step, 0, 0, loc)
+	case timeTickUnitHour:
+		return time.Date(year, month, day, hour-hour%u.step, 0, 0, 0, loc)
+	case timeTickUnitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case timeTickUnitWeek:
+		// weeks start on monday.
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+	case timeTickUnitMonth:
+		return time.Date(year, month-time.Month((int(month)-1)%u.step), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year-year%u.step, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// next returns the unit boundary following t, stepping by wall clock time so
+// that ticks stay aligned across daylight saving transitions.
+func (u timeTickUnit) next(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+
+	switch u.kind {
+	case timeTickUnitSecond:
+		return t.Add(time.Duration(u.step) * time.Second)
+	case timeTickUnitMinute:
+		return t.Add(time.Duration(u.step) * time.Minute)
+	case timeTickUnitHour:
+		next := time.Date(year, month, day, hour+u.step, minute, second, 0, loc)
+		if !next.After(t) {
+			// the wall clock was repeated by a daylight saving transition.
+			next = t.Add(time.Duration(u.step) * time.Hour)
+		}
+		return next
+	case timeTickUnitDay:
+		return time.Date(year, month, day+u.step, 0, 0, 0, 0, loc)
+	case timeTickUnitWeek:
+		return time.Date(year, month, day+7*u.step, 0, 0, 0, 0, loc)
+	case timeTickUnitMonth:
+		return time.Date(year, month+time.Month(u.step), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year+u.step, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
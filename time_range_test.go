@@ -0,0 +1,161 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func testTimeRangeTicks(t *testing.T, tr TimeRange) []Tick {
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	return tr.GetTicks(r, Style{Font: f, FontSize: 10.0}, TimeValueFormatter)
+}
+
+func TestTimeRangeGetTicksHours(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.FixedZone("test", -5*SecondsPerHour)
+	start := time.Date(2024, 3, 14, 7, 23, 0, 0, loc)
+	tr := TimeRange{
+		Min:      TimeToFloat64(start),
+		Max:      TimeToFloat64(start.Add(20 * time.Hour)),
+		Domain:   1024,
+		Location: loc,
+	}
+
+	ticks := testTimeRangeTicks(t, tr)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(loc)
+		testutil.AssertZero(t, tt.Minute())
+		testutil.AssertZero(t, tt.Second())
+		testutil.AssertEqual(t, tt.Format(DefaultDateHourFormat), tick.Label)
+	}
+
+	first := TimeFromFloat64(ticks[0].Value).In(loc)
+	testutil.AssertFalse(t, first.Before(start))
+	testutil.AssertZero(t, first.Hour()%3)
+}
+
+func TestTimeRangeGetTicksDays(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.UTC
+	start := time.Date(2024, 3, 14, 7, 23, 0, 0, loc)
+	tr := TimeRange{
+		Min:      TimeToFloat64(start),
+		Max:      TimeToFloat64(start.AddDate(0, 0, 10)),
+		Domain:   1024,
+		Location: loc,
+	}
+
+	ticks := testTimeRangeTicks(t, tr)
+	testutil.AssertLen(t, ticks, 10)
+	testutil.AssertEqual(t, "2024-03-15", ticks[0].Label)
+	testutil.AssertEqual(t, "2024-03-24", ticks[9].Label)
+}
+
+func TestTimeRangeGetTicksYears(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.UTC
+	tr := TimeRange{
+		Min:      TimeToFloat64(time.Date(1990, 6, 1, 0, 0, 0, 0, loc)),
+		Max:      TimeToFloat64(time.Date(2024, 6, 1, 0, 0, 0, 0, loc)),
+		Domain:   500,
+		Location: loc,
+	}
+
+	ticks := testTimeRangeTicks(t, tr)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(loc)
+		testutil.AssertEqual(t, time.January, tt.Month())
+		testutil.AssertEqual(t, 1, tt.Day())
+		testutil.AssertEqual(t, tt.Format(DefaultDateYearFormat), tick.Label)
+	}
+}
+
+func TestTimeRangeGetTicksLocation(t *testing.T) {
+	// replaced new assertions helper
+
+	loc := time.FixedZone("test", 9*SecondsPerHour)
+	start := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+	tr := TimeRange{
+		Min:      TimeToFloat64(start),
+		Max:      TimeToFloat64(start.AddDate(0, 0, 3)),
+		Domain:   1024,
+		Location: loc,
+	}
+
+	ticks := testTimeRangeTicks(t, tr)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(loc)
+		testutil.AssertZero(t, tt.Hour()%3)
+		testutil.AssertZero(t, tt.Minute())
+	}
+}
+
+func TestTimeRangeGetTicksValueFormatter(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+	tr := TimeRange{
+		Min:            TimeToFloat64(start),
+		Max:            TimeToFloat64(start.AddDate(0, 0, 3)),
+		Domain:         1024,
+		Location:       time.UTC,
+		ValueFormatter: func(v interface{}) string { return "x" },
+	}
+
+	ticks := testTimeRangeTicks(t, tr)
+	testutil.AssertNotEmpty(t, ticks)
+	testutil.AssertEqual(t, "x", ticks[0].Label)
+}
+
+func TestTimeRangeUnitAlign(t *testing.T) {
+	// replaced new assertions helper
+
+	// 2024-03-14 is a thursday.
+	ts := time.Date(2024, 3, 14, 19, 23, 47, 0, time.UTC)
+
+	week := timeTickUnit{kind: timeTickUnitWeek, step: 1}
+	testutil.AssertEqual(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), week.align(ts))
+	testutil.AssertEqual(t, time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), week.next(week.align(ts)))
+
+	quarter := timeTickUnit{kind: timeTickUnitMonth, step: 3}
+	testutil.AssertEqual(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), quarter.align(ts))
+	testutil.AssertEqual(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), quarter.next(quarter.align(ts)))
+
+	minutes := timeTickUnit{kind: timeTickUnitMinute, step: 15}
+	testutil.AssertEqual(t, time.Date(2024, 3, 14, 19, 15, 0, 0, time.UTC), minutes.align(ts))
+}
+
+func TestChartTimeRange(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 14, 7, 23, 0, 0, time.UTC)
+	c := Chart{
+		XAxis: XAxis{
+			Range: &TimeRange{Location: time.UTC},
+		},
+		Series: []Series{
+			TimeSeries{
+				XValues: []time.Time{start, start.Add(12 * time.Hour), start.Add(36 * time.Hour)},
+				YValues: []float64{1, 5, 3},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "03-15 12AM")
+}
@@ -150,3 +150,46 @@ func HoursFilled(xdata []time.Time, ydata []float64) ([]time.Time, []float64) {
 
 	return finalTimes, finalValues
 }
+
+// IsWeekDay returns if the day is a monday->friday.
+func IsWeekDay(day time.Weekday) bool {
+	return !IsWeekendDay(day)
+}
+
+// IsWeekendDay returns if the day is a saturday or sunday.
+func IsWeekendDay(day time.Weekday) bool {
+	return day == time.Saturday || day == time.Sunday
+}
+
+// DateEquals returns if two times are on the same calendar date, ignoring location.
+func DateEquals(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// DiffDays returns the number of calendar days from t1 to t2, ignoring location
+// and daylight saving transitions.
+func DiffDays(t1, t2 time.Time) int {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	from := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+// ClockTime returns the wall clock time on a given date offset from midnight,
+// in the date's location.
+func ClockTime(date time.Time, sinceMidnight time.Duration) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, int(sinceMidnight), date.Location())
+}
+
+// SinceMidnight returns the wall clock time elapsed since midnight.
+func SinceMidnight(t time.Time) time.Duration {
+	hour, minute, second := t.Clock()
+	return time.Duration(hour)*time.Hour +
+		time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second +
+		time.Duration(t.Nanosecond())
+}
@@ -0,0 +1,245 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*ValueAnnotationSeries)(nil)
+	_ ValuesProvider = (*ValueAnnotationSeries)(nil)
+	_ MeasuredSeries = (*ValueAnnotationSeries)(nil)
+
+	_ innerSeriesProvider = (*ValueAnnotationSeries)(nil)
+)
+
+// ValueAnnotationKind is an enum for which value of a series is annotated.
+type ValueAnnotationKind int
+
+const (
+	// ValueAnnotationKindLast annotates the last value that isn't NaN.
+	ValueAnnotationKindLast ValueAnnotationKind = 0
+	// ValueAnnotationKindFirst annotates the first value that isn't NaN.
+	ValueAnnotationKindFirst ValueAnnotationKind = 1
+	// ValueAnnotationKindMin annotates the smallest value.
+	ValueAnnotationKindMin ValueAnnotationKind = 2
+	// ValueAnnotationKindMax annotates the largest value.
+	ValueAnnotationKindMax ValueAnnotationKind = 3
+)
+
+// String returns the name of the kind of value.
+func (kind ValueAnnotationKind) String() string {
+	switch kind {
+	case ValueAnnotationKindFirst:
+		return "First Value"
+	case ValueAnnotationKindMin:
+		return "Min Value"
+	case ValueAnnotationKindMax:
+		return "Max Value"
+	default:
+		return "Last Value"
+	}
+}
+
+// LastValueAnnotationSeries returns an annotation series of the last value
+// of a series, pinned to the side of its y axis.
+// The label is formatted with the series' y value formatter, unless a
+// formatter is given.
+func LastValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) ValueAnnotationSeries {
+	return newValueAnnotationSeries(innerSeries, ValueAnnotationKindLast, vfs)
+}
+
+// FirstValueAnnotationSeries returns an annotation series of the first value
+// of a series, pinned to the side of its y axis.
+func FirstValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) ValueAnnotationSeries {
+	return newValueAnnotationSeries(innerSeries, ValueAnnotationKindFirst, vfs)
+}
+
+// MinValueAnnotationSeries returns an annotation series of the smallest
+// value of a series, pinned to the side of its y axis.
+func MinValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) ValueAnnotationSeries {
+	return newValueAnnotationSeries(innerSeries, ValueAnnotationKindMin, vfs)
+}
+
+// MaxValueAnnotationSeries returns an annotation series of the largest
+// value of a series, pinned to the side of its y axis.
+func MaxValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) ValueAnnotationSeries {
+	return newValueAnnotationSeries(innerSeries, ValueAnnotationKindMax, vfs)
+}
+
+func newValueAnnotationSeries(innerSeries ValuesProvider, kind ValueAnnotationKind, vfs []ValueFormatter) ValueAnnotationSeries {
+	vas := ValueAnnotationSeries{
+		Kind:        kind,
+		InnerSeries: innerSeries,
+	}
+	if len(vfs) > 0 {
+		vas.ValueFormatter = vfs[0]
+	}
+	return vas
+}
+
+// ValueAnnotationSeries annotates a value of a series, such as its last
+// value, with a callout pinned to the side of the series' y axis.
+//
+// The value, y axis and color are read from the inner series as the chart
+// is rendered, so changes to it are reflected. The callout takes the color
+// the inner series is drawn with if the inner series is in the same chart.
+// NaN values are skipped, and nothing is drawn if there are no other values.
+type ValueAnnotationSeries struct {
+	// Name defaults to the name of the inner series and the kind of value.
+	Name  string
+	Style Style
+
+	Kind        ValueAnnotationKind
+	InnerSeries ValuesProvider
+
+	// ValueFormatter formats the label; it defaults to the y value
+	// formatter of the inner series.
+	ValueFormatter ValueFormatter
+}
+
+// GetName returns the name of the series.
+func (vas ValueAnnotationSeries) GetName() string {
+	if len(vas.Name) > 0 {
+		return vas.Name
+	}
+	if typed, isTyped := vas.InnerSeries.(Series); isTyped && len(typed.GetName()) > 0 {
+		return fmt.Sprintf("%s - %s", typed.GetName(), vas.Kind)
+	}
+	return vas.Kind.String()
+}
+
+// GetStyle returns the series style, which is hidden if the inner series is.
+func (vas ValueAnnotationSeries) GetStyle() Style {
+	style := vas.Style
+	if typed, isTyped := vas.InnerSeries.(Series); isTyped && typed.GetStyle().Hidden {
+		style.Hidden = true
+	}
+	return style
+}
+
+// GetYAxis returns the y axis of the inner series.
+func (vas ValueAnnotationSeries) GetYAxis() YAxisType {
+	if typed, isTyped := vas.InnerSeries.(Series); isTyped {
+		return typed.GetYAxis()
+	}
+	return YAxisPrimary
+}
+
+// Len returns 1 if the inner series has a value to annotate, and 0 otherwise.
+func (vas ValueAnnotationSeries) Len() int {
+	if _, _, ok := vas.getValue(); ok {
+		return 1
+	}
+	return 0
+}
+
+// GetValues gets the annotated value.
+func (vas ValueAnnotationSeries) GetValues(_ int) (x, y float64) {
+	x, y, _ = vas.getValue()
+	return
+}
+
+// Measure returns the bounds of the callout, including its point.
+func (vas ValueAnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box {
+	return vas.annotationSeries().Measure(r, canvasBox, xrange, yrange, vas.calloutDefaults(defaults))
+}
+
+// Render draws the callout.
+func (vas ValueAnnotationSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	vas.annotationSeries().Render(r, canvasBox, xrange, yrange, vas.calloutDefaults(defaults))
+}
+
+// Validate validates the series.
+func (vas ValueAnnotationSeries) Validate() error {
+	if vas.InnerSeries == nil {
+		return fmt.Errorf("value annotation series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+// getInnerSeries returns the series whose style defaults the callout takes.
+func (vas ValueAnnotationSeries) getInnerSeries() interface{} {
+	return vas.InnerSeries
+}
+
+// calloutDefaults returns the defaults with the resolved style of the inner series.
+func (vas ValueAnnotationSeries) calloutDefaults(defaults Style) Style {
+	if typed, isTyped := vas.InnerSeries.(Series); isTyped {
+		return typed.GetStyle().InheritFrom(defaults)
+	}
+	return defaults
+}
+
+// annotationSeries returns the annotation series the value is drawn as,
+// which has no annotations if there is no value.
+func (vas ValueAnnotationSeries) annotationSeries() AnnotationSeries {
+	as := AnnotationSeries{
+		Name:       vas.GetName(),
+		Style:      vas.GetStyle(),
+		YAxis:      vas.GetYAxis(),
+		PinToYAxis: true,
+	}
+	x, y, ok := vas.getValue()
+	if !ok {
+		return as
+	}
+
+	vf := vas.ValueFormatter
+	if vf == nil {
+		if typed, isTyped := vas.InnerSeries.(ValueFormatterProvider); isTyped {
+			_, vf = typed.GetValueFormatters()
+		}
+	}
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	as.Annotations = []Value2{{XValue: x, YValue: y, Label: vf(y)}}
+	return as
+}
+
+// getValue returns the annotated value, if the inner series has one that isn't NaN.
+func (vas ValueAnnotationSeries) getValue() (x, y float64, ok bool) {
+	if vas.InnerSeries == nil || vas.InnerSeries.Len() == 0 {
+		return
+	}
+	switch vas.Kind {
+	case ValueAnnotationKindFirst:
+		if typed, isTyped := vas.InnerSeries.(FirstValuesProvider); isTyped {
+			// a NaN value falls back to scanning for the first value that isn't.
+			if x, y = typed.GetFirstValues(); !math.IsNaN(y) {
+				return x, y, true
+			}
+		}
+		return vas.scan(func(_, _ float64) bool { return false })
+	case ValueAnnotationKindMin:
+		return vas.scan(func(y, best float64) bool { return y < best })
+	case ValueAnnotationKindMax:
+		return vas.scan(func(y, best float64) bool { return y > best })
+	default:
+		if typed, isTyped := vas.InnerSeries.(LastValuesProvider); isTyped {
+			// a NaN value falls back to scanning for the last value that isn't.
+			if x, y = typed.GetLastValues(); !math.IsNaN(y) {
+				return x, y, true
+			}
+		}
+		return vas.scan(func(_, _ float64) bool { return true })
+	}
+}
+
+// scan returns the value of the inner series that replaces the best one
+// found so far, starting from the first; NaN values are skipped.
+func (vas ValueAnnotationSeries) scan(replaces func(y, best float64) bool) (bestX, bestY float64, found bool) {
+	for index := 0; index < vas.InnerSeries.Len(); index++ {
+		x, y := vas.InnerSeries.GetValues(index)
+		if math.IsNaN(y) {
+			continue
+		}
+		if !found || replaces(y, bestY) {
+			bestX, bestY = x, y
+			found = true
+		}
+	}
+	return
+}
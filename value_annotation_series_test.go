@@ -0,0 +1,217 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+// testValuesProvider is a values provider without first or last values.
+type testValuesProvider struct {
+	xs, ys []float64
+}
+
+func (tvp testValuesProvider) Len() int {
+	return len(tvp.xs)
+}
+
+func (tvp testValuesProvider) GetValues(index int) (x, y float64) {
+	return tvp.xs[index], tvp.ys[index]
+}
+
+func TestValueAnnotationSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ContinuousSeries{
+		Name:    "Test",
+		Style:   Style{StrokeColor: ColorRed, FillColor: ColorBlue},
+		YAxis:   YAxisSecondary,
+		XValues: []float64{1, 2, 3, 4, 5},
+		YValues: []float64{3, 5, math.NaN(), 1, 2},
+	}
+
+	last := LastValueAnnotationSeries(cs)
+	testutil.AssertEqual(t, "Test - Last Value", last.GetName())
+	testutil.AssertEqual(t, YAxisSecondary, last.GetYAxis())
+	testutil.AssertNil(t, last.Validate())
+	as := last.annotationSeries()
+	testutil.AssertTrue(t, as.PinToYAxis)
+	testutil.AssertTrue(t, as.Style.StrokeColor.IsZero())
+	testutil.AssertLen(t, as.Annotations, 1)
+	testutil.AssertEqual(t, Value2{XValue: 5, YValue: 2, Label: "2.00"}, as.Annotations[0])
+
+	// the callout takes its colors from the inner series.
+	defaults := last.calloutDefaults(Style{StrokeColor: ColorBlack})
+	testutil.AssertEqual(t, ColorRed, defaults.StrokeColor)
+	testutil.AssertEqual(t, ColorBlue, defaults.FillColor)
+
+	first := FirstValueAnnotationSeries(cs, IntValueFormatter)
+	testutil.AssertEqual(t, "Test - First Value", first.GetName())
+	testutil.AssertEqual(t, Value2{XValue: 1, YValue: 3, Label: "3"}, first.annotationSeries().Annotations[0])
+
+	// NaN values are skipped.
+	testutil.AssertEqual(t, Value2{XValue: 4, YValue: 1, Label: "1.00"}, MinValueAnnotationSeries(cs).annotationSeries().Annotations[0])
+	testutil.AssertEqual(t, Value2{XValue: 2, YValue: 5, Label: "5.00"}, MaxValueAnnotationSeries(cs).annotationSeries().Annotations[0])
+}
+
+func TestValueAnnotationSeriesInnerSeriesChanged(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := &ContinuousSeries{
+		Name:    "Test",
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{3, 5, 4},
+	}
+	last := LastValueAnnotationSeries(cs)
+	max := MaxValueAnnotationSeries(cs)
+
+	cs.XValues = append(cs.XValues, 4)
+	cs.YValues = append(cs.YValues, 8)
+	cs.Name = "Changed"
+	cs.YAxis = YAxisSecondary
+
+	testutil.AssertEqual(t, "Changed - Last Value", last.GetName())
+	testutil.AssertEqual(t, YAxisSecondary, last.GetYAxis())
+	testutil.AssertEqual(t, Value2{XValue: 4, YValue: 8, Label: "8.00"}, last.annotationSeries().Annotations[0])
+	testutil.AssertEqual(t, Value2{XValue: 4, YValue: 8, Label: "8.00"}, max.annotationSeries().Annotations[0])
+}
+
+func TestValueAnnotationSeriesScansValues(t *testing.T) {
+	// replaced new assertions helper
+
+	vp := testValuesProvider{
+		xs: []float64{1, 2, 3},
+		ys: []float64{4, 6, math.NaN()},
+	}
+
+	last := LastValueAnnotationSeries(vp)
+	testutil.AssertEqual(t, "Last Value", last.GetName())
+	testutil.AssertEqual(t, YAxisPrimary, last.GetYAxis())
+	testutil.AssertEqual(t, Value2{XValue: 2, YValue: 6, Label: "6.00"}, last.annotationSeries().Annotations[0])
+	testutil.AssertEqual(t, Value2{XValue: 1, YValue: 4, Label: "4.00"}, FirstValueAnnotationSeries(vp).annotationSeries().Annotations[0])
+
+	// the provided first and last values are skipped if they are NaN.
+	cs := ContinuousSeries{
+		XValues: []float64{1, 2, 3, 4},
+		YValues: []float64{math.NaN(), 4, 6, math.NaN()},
+	}
+	testutil.AssertEqual(t, Value2{XValue: 3, YValue: 6, Label: "6.00"}, LastValueAnnotationSeries(cs).annotationSeries().Annotations[0])
+	testutil.AssertEqual(t, Value2{XValue: 2, YValue: 4, Label: "4.00"}, FirstValueAnnotationSeries(cs).annotationSeries().Annotations[0])
+
+	// a series without values that aren't NaN has nothing to annotate.
+	empty := MaxValueAnnotationSeries(testValuesProvider{})
+	testutil.AssertNil(t, empty.Validate())
+	testutil.AssertZero(t, empty.Len())
+	testutil.AssertEmpty(t, empty.annotationSeries().Annotations)
+
+	nans := LastValueAnnotationSeries(testValuesProvider{xs: []float64{1, 2}, ys: []float64{math.NaN(), math.NaN()}})
+	testutil.AssertNil(t, nans.Validate())
+	testutil.AssertZero(t, nans.Len())
+	testutil.AssertEmpty(t, nans.annotationSeries().Annotations)
+
+	testutil.AssertNotNil(t, ValueAnnotationSeries{}.Validate())
+}
+
+func TestValueAnnotationSeriesPinToYAxis(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := SVG(200, 200)
+	testutil.AssertNil(t, err)
+
+	canvasBox := Box{Top: 10, Left: 10, Right: 190, Bottom: 190}
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 180}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 180}
+
+	vp := testValuesProvider{xs: []float64{5}, ys: []float64{5}}
+	as := LastValueAnnotationSeries(vp).annotationSeries()
+	callouts := as.layout(r, canvasBox, xrange, yrange, Style{Font: f})
+	testutil.AssertLen(t, callouts, 1)
+	testutil.AssertEqual(t, canvasBox.Right, callouts[0].x)
+	testutil.AssertEqual(t, 100, callouts[0].y)
+
+	as.YAxis = YAxisSecondary
+	callouts = as.layout(r, canvasBox, xrange, yrange, Style{Font: f})
+	testutil.AssertEqual(t, canvasBox.Left, callouts[0].x)
+
+	as.PinToYAxis = false
+	callouts = as.layout(r, canvasBox, xrange, yrange, Style{Font: f})
+	testutil.AssertEqual(t, 100, callouts[0].x)
+}
+
+func TestValueAnnotationSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ContinuousSeries{
+		Name:    "Test",
+		XValues: []float64{1, 2, 3, 4, 5},
+		YValues: []float64{3, 5, 4, 1, 2},
+	}
+	c := Chart{
+		Series: []Series{
+			cs,
+			LastValueAnnotationSeries(cs),
+			MinValueAnnotationSeries(cs),
+			MaxValueAnnotationSeries(cs),
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">5.00</text>")
+	testutil.AssertContains(t, buffer.String(), ">1.00</text>")
+
+	buffer.Reset()
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestValueAnnotationSeriesRenderStyle(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{3, 5, 4},
+	}
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			cs,
+			LastValueAnnotationSeries(cs),
+		},
+	}
+
+	// the callout is drawn in the palette color of the series it annotates.
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	color := c.GetColorPalette().GetSeriesColor(1).String()
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), "stroke:"+color))
+}
+
+func TestValueAnnotationSeriesRenderEmpty(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{3, 5, 4},
+	}
+	nans := testValuesProvider{
+		xs: []float64{1, 2, 3},
+		ys: []float64{math.NaN(), math.NaN(), math.NaN()},
+	}
+	c := Chart{
+		Series: []Series{
+			cs,
+			LastValueAnnotationSeries(nans),
+			MaxValueAnnotationSeries(ContinuousSeries{}),
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertNotContains(t, buffer.String(), "NaN")
+}
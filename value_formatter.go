@@ -11,40 +11,36 @@ type ValueFormatter func(v interface{}) string
 
 var (
 	// Unix to string
-	cachingTimeFormat = make(map[int64]string)
+	cachingTimeFormat = newStringCache(DefaultStringCacheCapacity)
 )
 
 // TimeValueFormatter is a ValueFormatter for timestamps.
 func TimeValueFormatter(v interface{}) string {
 	if typed, isTyped := v.(time.Time); isTyped {
-		if ccache, ok := cachingTimeFormat[typed.Unix()]; ok {
-			return ccache
-		}
-		result := typed.Format(DefaultDateFormat)
-		cachingTimeFormat[typed.Unix()] = result
-		return result
+		return formatCachedTime(typed)
 	}
 	if typed, isTyped := v.(int64); isTyped {
-		unix := time.Unix(0, typed)
-		if ccache, ok := cachingTimeFormat[unix.Unix()]; ok {
-			return ccache
-		}
-		result := unix.Format(DefaultDateFormat)
-		cachingTimeFormat[unix.Unix()] = result
-		return result
+		return formatCachedTime(time.Unix(0, typed))
 	}
 	if typed, isTyped := v.(float64); isTyped {
-		unix := time.Unix(0, int64(typed))
-		if ccache, ok := cachingTimeFormat[unix.Unix()]; ok {
-			return ccache
-		}
-		result := unix.Format(DefaultDateFormat)
-		cachingTimeFormat[unix.Unix()] = result
-		return result
+		return formatCachedTime(time.Unix(0, int64(typed)))
 	}
 	return ""
 }
 
+// timeFormatKey identifies a formatted date; the location is included as the
+// same instant can fall on different dates.
+type timeFormatKey struct {
+	unix     int64
+	location *time.Location
+}
+
+func formatCachedTime(t time.Time) string {
+	return cachingTimeFormat.GetOrAdd(timeFormatKey{t.Unix(), t.Location()}, func() string {
+		return t.Format(DefaultDateFormat)
+	})
+}
+
 // TimeHourValueFormatter is a ValueFormatter for timestamps.
 func TimeHourValueFormatter(v interface{}) string {
 	return formatTime(v, DefaultDateHourFormat)
@@ -14,6 +14,19 @@ type BoundedValuesProvider interface {
 	GetBoundedValues(index int) (x, y1, y2 float64)
 }
 
+// ValueBoundsProvider is a series that covers more than its values, such as
+// bars that are wider than a point, and gives the bounds of its values directly.
+type ValueBoundsProvider interface {
+	Len() int
+	GetValueBounds() (minx, maxx, miny, maxy float64)
+}
+
+// valuesSnapshotProvider is a series that computes its values on each call,
+// and can compute them once for repeated access.
+type valuesSnapshotProvider interface {
+	getValuesSnapshot() ValuesProvider
+}
+
 // FirstValuesProvider is a special type of value provider that can return it's (potentially computed) first value.
 type FirstValuesProvider interface {
 	GetFirstValues() (x, y float64)
@@ -2,9 +2,11 @@ package chart
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/image/font"
 	"golang.org/x/image/math/fixed"
@@ -16,41 +18,58 @@ import (
 
 // SVG returns a new png/raster renderer.
 func SVG(width, height int) (Renderer, error) {
-	buffer := bytebufferpool.Get()
-	buffer2 := bytebufferpool.Get()
-
-	canvas := newCanvas(buffer)
-	canvas.Start(width, height)
-	return &vectorRenderer{
-		b:   buffer,
-		c:   canvas,
-		s:   &Style{},
-		p:   buffer2,
-		dpi: DefaultDPI,
-	}, nil
+	return SVGWithOptions(SVGOptions{})(width, height)
 }
 
 // SVGWithCSS returns a new png/raster renderer with attached custom CSS
 // The optional nonce argument sets a CSP nonce.
 func SVGWithCSS(css string, nonce string) func(width, height int) (Renderer, error) {
+	return SVGWithOptions(SVGOptions{CSS: css, Nonce: nonce})
+}
+
+// SVGOptions are options for the svg renderer.
+type SVGOptions struct {
+	// CSS is a stylesheet embedded in the svg.
+	CSS string
+	// Nonce sets a CSP nonce on the stylesheet.
+	Nonce string
+	// HitTargets draws an invisible target over each data point, with a
+	// tooltip and the formatted values as data-x and data-y attributes.
+	// Targets have the "hit-target" class, so they can be styled on hover.
+	HitTargets bool
+}
+
+// SVGWithOptions returns a new svg renderer provider with the given options.
+func SVGWithOptions(options SVGOptions) func(width, height int) (Renderer, error) {
 	return func(width, height int) (Renderer, error) {
 		buffer := bytebufferpool.Get()
 		buffer2 := bytebufferpool.Get()
 
 		canvas := newCanvas(buffer)
-		canvas.css = css
-		canvas.nonce = nonce
+		canvas.css = options.CSS
+		canvas.nonce = options.Nonce
 		canvas.Start(width, height)
 		return &vectorRenderer{
-			b:   buffer,
-			c:   canvas,
-			s:   &Style{},
-			p:   buffer2,
-			dpi: DefaultDPI,
+			b:          buffer,
+			c:          canvas,
+			s:          &Style{},
+			p:          buffer2,
+			dpi:        DefaultDPI,
+			hitTargets: options.HitTargets,
 		}, nil
 	}
 }
 
+// Interface Assertions.
+var (
+	_ AccessibleRenderer = (*vectorRenderer)(nil)
+	_ GradientRenderer   = (*vectorRenderer)(nil)
+	_ GroupRenderer      = (*vectorRenderer)(nil)
+	_ HitTargetRenderer  = (*vectorRenderer)(nil)
+	_ PatternRenderer    = (*vectorRenderer)(nil)
+	_ RendererF          = (*vectorRenderer)(nil)
+)
+
 // vectorRenderer renders chart commands to a bitmap.
 type vectorRenderer struct {
 	dpi float64
@@ -58,12 +77,16 @@ type vectorRenderer struct {
 	c   *canvas
 	s   *Style
 	p   *bytebufferpool.ByteBuffer
-	fc  font.Face
+
+	// faces are the font faces used to measure text, keyed by font, dpi and size.
+	// font.Face is not safe for concurrent use so they are not shared between renderers.
+	faces map[string]font.Face
+
+	hitTargets bool
 }
 
 func (vr *vectorRenderer) ResetStyle() {
 	vr.s = &Style{Font: vr.s.Font}
-	vr.fc = nil
 }
 
 // GetDPI returns the dpi.
@@ -90,6 +113,18 @@ func (vr *vectorRenderer) SetStrokeColor(c drawing.Color) {
 // SetFillColor implements the interface method.
 func (vr *vectorRenderer) SetFillColor(c drawing.Color) {
 	vr.s.FillColor = c
+	vr.s.FillGradient = nil
+	vr.s.FillPattern = nil
+}
+
+// SetFillGradient implements the GradientRenderer interface method.
+func (vr *vectorRenderer) SetFillGradient(gradient *drawing.Gradient) {
+	vr.s.FillGradient = gradient
+}
+
+// SetFillPattern implements the PatternRenderer interface method.
+func (vr *vectorRenderer) SetFillPattern(pattern *drawing.Pattern) {
+	vr.s.FillPattern = pattern
 }
 
 // SetLineWidth implements the interface method.
@@ -144,6 +179,70 @@ func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
 	_, _ = vr.p.WriteString(itoa(y))
 }
 
+var (
+	cStart = []byte("C")
+)
+
+// CubicCurveTo draws a cubic curve.
+func (vr *vectorRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	_, _ = vr.p.Write(cStart)
+	_, _ = vr.p.WriteString(itoa(cx1))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(itoa(cy1))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(itoa(cx2))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(itoa(cy2))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(itoa(x))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(itoa(y))
+}
+
+// MoveToF implements the RendererF interface method.
+func (vr *vectorRenderer) MoveToF(x, y float64) {
+	_, _ = vr.p.Write(mStart)
+	_, _ = vr.p.WriteString(ftoaPath(x))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(ftoaPath(y))
+}
+
+// LineToF implements the RendererF interface method.
+func (vr *vectorRenderer) LineToF(x, y float64) {
+	_, _ = vr.p.Write(lStart)
+	_, _ = vr.p.WriteString(ftoaPath(x))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(ftoaPath(y))
+}
+
+// QuadCurveToF implements the RendererF interface method.
+func (vr *vectorRenderer) QuadCurveToF(cx, cy, x, y float64) {
+	_, _ = vr.p.Write(qStart)
+	_, _ = vr.p.WriteString(ftoaPath(cx))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(ftoaPath(cy))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(ftoaPath(x))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(ftoaPath(y))
+}
+
+// CubicCurveToF implements the RendererF interface method.
+func (vr *vectorRenderer) CubicCurveToF(cx1, cy1, cx2, cy2, x, y float64) {
+	_, _ = vr.p.Write(cStart)
+	_, _ = vr.p.WriteString(ftoaPath(cx1))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(ftoaPath(cy1))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(ftoaPath(cx2))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(ftoaPath(cy2))
+	_, _ = vr.p.Write(space)
+	_, _ = vr.p.WriteString(ftoaPath(x))
+	_, _ = vr.p.Write(comma)
+	_, _ = vr.p.WriteString(ftoaPath(y))
+}
+
 var (
 	zClose = []byte("Z")
 )
@@ -179,6 +278,11 @@ func (vr *vectorRenderer) Circle(radius float64, x, y int) {
 	vr.c.Circle(x, y, int(radius), vr.s.GetFillAndStrokeOptions())
 }
 
+// CircleF implements the RendererF interface method.
+func (vr *vectorRenderer) CircleF(radius, x, y float64) {
+	vr.c.circle(ftoaPath(x), ftoaPath(y), ftoaPath(radius), vr.s.GetFillAndStrokeOptions())
+}
+
 // SetFont implements the interface method.
 func (vr *vectorRenderer) SetFont(f *truetype.Font) {
 	vr.s.Font = f
@@ -199,29 +303,89 @@ func (vr *vectorRenderer) Text(body string, x, y int) {
 	vr.c.Text(x, y, body, vr.s.GetTextOptions())
 }
 
-type runeCache map[rune]fixed.Int26_6
+// SetTitle implements the AccessibleRenderer interface method.
+func (vr *vectorRenderer) SetTitle(title string) {
+	vr.c.title = title
+}
+
+// SetDescription implements the AccessibleRenderer interface method.
+func (vr *vectorRenderer) SetDescription(description string) {
+	vr.c.description = description
+}
+
+// BeginGroup implements the GroupRenderer interface method.
+func (vr *vectorRenderer) BeginGroup(group Group) {
+	vr.c.BeginGroup(group)
+}
+
+// EndGroup implements the GroupRenderer interface method.
+func (vr *vectorRenderer) EndGroup() {
+	vr.c.EndGroup()
+}
+
+// HitTargetsEnabled implements the HitTargetRenderer interface method.
+func (vr *vectorRenderer) HitTargetsEnabled() bool {
+	return vr.hitTargets
+}
+
+// HitTarget implements the HitTargetRenderer interface method.
+func (vr *vectorRenderer) HitTarget(target HitTarget) {
+	if vr.hitTargets {
+		vr.c.HitTarget(target)
+	}
+}
+
+// AddDataTable implements the AccessibleRenderer interface method.
+func (vr *vectorRenderer) AddDataTable(table DataTable) {
+	vr.c.tables = append(vr.c.tables, table)
+}
+
+const (
+	// DefaultGlyphCacheCapacity is the number of glyph advances the text
+	// measuring cache holds, across all fonts and sizes, before it is cleared.
+	DefaultGlyphCacheCapacity = 16384
+)
+
+// glyphKey identifies the advance of a glyph in a font face.
+type glyphKey struct {
+	cacheName string
+	c         rune
+}
 
 var (
-	fontCache  = map[string]font.Face{}
-	fontToRune = map[string]runeCache{}
+	glyphAdvances      = make(map[glyphKey]fixed.Int26_6)
+	glyphAdvancesMutex sync.RWMutex
 )
 
-// Implement a caching measureString
-func measureString(str, fontName string, font font.Face) fixed.Int26_6 {
-	cache := fontToRune[fontName]
-	if cache == nil {
-		cache = make(runeCache)
+func getGlyphAdvance(cacheName string, c rune) (advance fixed.Int26_6, ok bool) {
+	glyphAdvancesMutex.RLock()
+	defer glyphAdvancesMutex.RUnlock()
+	advance, ok = glyphAdvances[glyphKey{cacheName, c}]
+	return
+}
+
+// setGlyphAdvance caches the advance of a glyph, clearing the cache once it
+// is full so rendering many fonts and sizes doesn't grow it without bound.
+func setGlyphAdvance(cacheName string, c rune, advance fixed.Int26_6) {
+	glyphAdvancesMutex.Lock()
+	defer glyphAdvancesMutex.Unlock()
+	if len(glyphAdvances) >= DefaultGlyphCacheCapacity {
+		glyphAdvances = make(map[glyphKey]fixed.Int26_6)
 	}
+	glyphAdvances[glyphKey{cacheName, c}] = advance
+}
+
+// Implement a caching measureString
+// The cache name must identify the face, including its dpi and size.
+func measureString(str, cacheName string, font font.Face) fixed.Int26_6 {
 	prevC := rune(-1)
 	var advance fixed.Int26_6
 	for _, c := range str {
 		if prevC >= 0 {
 			advance += font.Kern(prevC, c)
 		}
-		var a fixed.Int26_6
-		if res, ok := cache[c]; ok {
-			a = res
-		} else {
+		a, ok := getGlyphAdvance(cacheName, c)
+		if !ok {
 			a, ok = font.GlyphAdvance(c)
 			if !ok {
 				// TODO: is falling back on the U+FFFD glyph the responsibility of
@@ -229,12 +393,11 @@ func measureString(str, fontName string, font font.Face) fixed.Int26_6 {
 				// TODO: set prevC = '\ufffd'?
 				continue
 			}
-			cache[c] = a
+			setGlyphAdvance(cacheName, c, a)
 		}
 		advance += a
 		prevC = c
 	}
-	fontToRune[fontName] = cache
 	return advance
 }
 
@@ -243,20 +406,18 @@ func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	if vr.s.GetFont() != nil {
 		fontName := vr.s.GetFont().Name(truetype.NameIDFontFamily)
 		cacheName := fontName + strconv.FormatInt(int64(vr.dpi), 10) + strconv.FormatInt(int64(vr.s.FontSize), 10)
-		if vr.fc == nil {
-			if f, ok := fontCache[cacheName]; ok {
-				vr.fc = f
-			} else {
-				newFace := truetype.NewFace(vr.s.GetFont(), &truetype.Options{
-					DPI:  vr.dpi,
-					Size: vr.s.FontSize,
-				})
-				vr.fc = newFace
-				fontCache[cacheName] = newFace
+		fc, ok := vr.faces[cacheName]
+		if !ok {
+			fc = truetype.NewFace(vr.s.GetFont(), &truetype.Options{
+				DPI:  vr.dpi,
+				Size: vr.s.FontSize,
+			})
+			if vr.faces == nil {
+				vr.faces = make(map[string]font.Face)
 			}
-
+			vr.faces[cacheName] = fc
 		}
-		w := measureString(body, fontName, vr.fc).Ceil()
+		w := measureString(body, cacheName, fc).Ceil()
 
 		box.Right = w
 		box.Bottom = int(drawing.PointsToPixels(vr.dpi, vr.s.FontSize))
@@ -281,7 +442,7 @@ func (vr *vectorRenderer) ClearTextRotation() {
 // Save saves the renderer's contents to a writer.
 func (vr *vectorRenderer) Save(w io.Writer) error {
 	vr.c.End()
-	_, err := w.Write(vr.b.Bytes())
+	err := vr.c.Save(w)
 	bytebufferpool.Put(vr.b)
 	return err
 }
@@ -301,6 +462,33 @@ type canvas struct {
 	height    int
 	css       string
 	nonce     string
+
+	title       string
+	description string
+	tables      []DataTable
+
+	// rootEnd is the offset of the end of the root element's attributes and
+	// bodyStart the offset of its first child, before the stylesheet, where
+	// the accessibility metadata is inserted once it is known.
+	rootEnd   int
+	bodyStart int
+
+	// definitions are the ids of the gradients and patterns defined so far.
+	definitions map[string]bool
+}
+
+// xmlEscaper escapes text for use in xml character data and attribute values.
+var xmlEscaper = strings.NewReplacer(
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+	`"`, "&quot;",
+	`'`, "&#39;",
+)
+
+// escapeXML escapes text for use in xml character data and attribute values.
+func escapeXML(text string) string {
+	return xmlEscaper.Replace(text)
 }
 
 var (
@@ -324,15 +512,20 @@ func (c *canvas) Start(width, height int) {
 	_, _ = c.w.WriteString(sWidth)
 	_, _ = c.w.Write(canvasHeight)
 	_, _ = c.w.WriteString(sHeight)
+	// the closing quote of the height is part of the attributes.
+	c.rootEnd = c.w.Len() + 1
 	_, _ = c.w.Write(canvasEnd)
+	// the title and description must be the first children of the root.
+	c.bodyStart = c.w.Len()
 	if c.css != "" {
 		_, _ = c.w.Write([]byte(`<style type="text/css"`))
 		if c.nonce != "" {
 			// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy
-			_, _ = c.w.Write([]byte(fmt.Sprintf(` nonce="%s"`, c.nonce)))
+			_, _ = c.w.Write([]byte(fmt.Sprintf(` nonce="%s"`, escapeXML(c.nonce))))
 		}
 		// To avoid compatibility issues between XML and CSS (f.e. with child selectors) we should encapsulate the CSS with CDATA.
-		_, _ = c.w.Write([]byte(fmt.Sprintf(`><![CDATA[%s]]></style>`, c.css)))
+		// A CDATA section can't contain its own terminator, so it is split around any in the CSS.
+		_, _ = c.w.Write([]byte(fmt.Sprintf(`><![CDATA[%s]]></style>`, strings.ReplaceAll(c.css, "]]>", "]]]]><![CDATA[>"))))
 	}
 }
 
@@ -344,6 +537,7 @@ var (
 )
 
 func (c *canvas) Path(d string, style Style) {
+	c.defineFill(style)
 	_, _ = c.w.Write(pathStart)
 	if len(style.StrokeDashArray) > 0 {
 		_, _ = c.w.WriteString(c.getStrokeDashArray(style))
@@ -388,10 +582,71 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 		_, _ = c.w.Write(transformEnds)
 	}
 	_, _ = c.w.Write(textMark2)
-	_, _ = c.w.WriteString(body)
+	_, _ = c.w.WriteString(escapeXML(body))
 	_, _ = c.w.Write(textEnd)
 }
 
+var (
+	groupStart = []byte(`<g`)
+	groupID    = []byte(` id="`)
+	groupClass = []byte(` class="`)
+	groupLabel = []byte(` role="group" aria-label="`)
+	groupMark  = []byte(`"`)
+	groupEnd   = []byte(`</g>`)
+)
+
+// BeginGroup starts a group of elements.
+func (c *canvas) BeginGroup(group Group) {
+	_, _ = c.w.Write(groupStart)
+	for _, attribute := range []struct {
+		start []byte
+		value string
+	}{
+		{groupID, group.ID},
+		{groupClass, group.ClassName},
+		{groupLabel, group.Label},
+	} {
+		if len(attribute.value) > 0 {
+			_, _ = c.w.Write(attribute.start)
+			_, _ = c.w.WriteString(escapeXML(attribute.value))
+			_, _ = c.w.Write(groupMark)
+		}
+	}
+	_, _ = c.w.Write(textMark2)
+}
+
+// EndGroup ends the current group of elements.
+func (c *canvas) EndGroup() {
+	_, _ = c.w.Write(groupEnd)
+}
+
+// HitTarget draws an invisible shape that still receives pointer events,
+// with the tooltip as its title.
+func (c *canvas) HitTarget(target HitTarget) {
+	box := target.Box
+	var element string
+	if target.Round {
+		element = "circle"
+		_, _ = c.w.WriteString(`<circle cx="` + itoa(box.Left+(box.Width()>>1)) +
+			`" cy="` + itoa(box.Top+(box.Height()>>1)) +
+			`" r="` + itoa(MinInt(box.Width(), box.Height())>>1))
+	} else {
+		element = "rect"
+		_, _ = c.w.WriteString(`<rect x="` + itoa(box.Left) +
+			`" y="` + itoa(box.Top) +
+			`" width="` + itoa(box.Width()) +
+			`" height="` + itoa(box.Height()))
+	}
+	_, _ = c.w.WriteString(`" class="hit-target" style="fill:transparent;stroke:none" pointer-events="all"`)
+	if len(target.XValue) > 0 {
+		_, _ = c.w.WriteString(` data-x="` + escapeXML(target.XValue) + `"`)
+	}
+	if len(target.YValue) > 0 {
+		_, _ = c.w.WriteString(` data-y="` + escapeXML(target.YValue) + `"`)
+	}
+	_, _ = c.w.WriteString(`><title>` + escapeXML(target.Tooltip) + `</title></` + element + `>`)
+}
+
 var (
 	circleStart  = []byte(`<circle cx="`)
 	circleCY     = []byte(`" cy="`)
@@ -401,25 +656,187 @@ var (
 )
 
 func (c *canvas) Circle(x, y, r int, style Style) {
+	c.circle(itoa(x), itoa(y), itoa(r), style)
+}
+
+func (c *canvas) circle(x, y, r string, style Style) {
+	c.defineFill(style)
 	_, _ = c.w.Write(circleStart)
-	_, _ = c.w.WriteString(itoa(x))
+	_, _ = c.w.WriteString(x)
 	_, _ = c.w.Write(circleCY)
-	_, _ = c.w.WriteString(itoa(y))
+	_, _ = c.w.WriteString(y)
 	_, _ = c.w.Write(circleRadius)
-	_, _ = c.w.WriteString(itoa(r))
+	_, _ = c.w.WriteString(r)
 	_, _ = c.w.Write(circleMark)
 	_, _ = c.w.WriteString(c.styleAsSVG(style))
 	_, _ = c.w.Write(circleEnd)
 }
 
+// gradientDefinition returns the id and markup of a gradient element.
+// The id is derived from the markup, so it is the same for equal gradients
+// and distinct for different ones, even across documents on the same page.
+func gradientDefinition(g *drawing.Gradient) (id, markup string) {
+	var element, attributes string
+	if g.Type == drawing.GradientTypeRadial {
+		element = "radialGradient"
+		attributes = `cx="` + ftoaPath(g.CX) + `" cy="` + ftoaPath(g.CY) + `" r="` + ftoaPath(g.R) + `"`
+	} else {
+		element = "linearGradient"
+		attributes = `x1="` + ftoaPath(g.X1) + `" y1="` + ftoaPath(g.Y1) + `" x2="` + ftoaPath(g.X2) + `" y2="` + ftoaPath(g.Y2) + `"`
+	}
+
+	var stops strings.Builder
+	for _, stop := range g.Stops {
+		stops.WriteString(`<stop offset="` + ftoaPath(stop.Offset) + `" stop-color="` + stop.Color.String() + `"/>`)
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(attributes + stops.String()))
+	id = "gradient-" + strconv.FormatUint(hash.Sum64(), 36)
+	markup = `<defs><` + element + ` id="` + id + `" ` + attributes + `>` + stops.String() + `</` + element + `></defs>`
+	return
+}
+
+// patternDefinition returns the id and markup of a pattern element drawn
+// over a background color, unless the pattern has its own.
+func patternDefinition(p *drawing.Pattern, background drawing.Color) (id, markup string) {
+	size := p.GetSize()
+	width := p.GetLineWidth()
+	if !p.Background.IsZero() {
+		background = p.Background
+	}
+	s, half := ftoaPath(size), ftoaPath(size/2)
+
+	var content string
+	if !background.IsZero() {
+		content = `<rect width="` + s + `" height="` + s + `" fill="` + background.String() + `"/>`
+	}
+	lineStyle := `" stroke="` + p.GetColor().String() + `" stroke-width="` + ftoaPath(width) + `" fill="none"/>`
+	switch p.Type {
+	case drawing.PatternTypeCross:
+		content += `<path d="M 0 ` + half + `L ` + s + ` ` + half + `M ` + half + ` 0L ` + half + ` ` + s + lineStyle
+	case drawing.PatternTypeDots:
+		content += `<circle cx="` + half + `" cy="` + half + `" r="` + ftoaPath(width) + `" fill="` + p.GetColor().String() + `"/>`
+	case drawing.PatternTypeHorizontal:
+		content += `<path d="M 0 ` + half + `L ` + s + ` ` + half + lineStyle
+	default:
+		// the diagonal through the tile, and the ends of the neighboring
+		// diagonals in its corners.
+		end, start := ftoaPath(size*1.5), ftoaPath(-size/2)
+		content += `<path d="M 0 ` + s + `L ` + s + ` 0M ` + start + ` ` + half + `L ` + half + ` ` + start +
+			`M ` + half + ` ` + end + `L ` + end + ` ` + half + lineStyle
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(content))
+	id = "pattern-" + strconv.FormatUint(hash.Sum64(), 36)
+	markup = `<defs><pattern id="` + id + `" patternUnits="userSpaceOnUse" width="` + s + `" height="` + s + `">` + content + `</pattern></defs>`
+	return
+}
+
+// fillDefinition returns the id and markup of the pattern or gradient a
+// style is filled with, if any.
+func fillDefinition(s Style) (id, markup string) {
+	if s.FillPattern != nil {
+		return patternDefinition(s.FillPattern, s.FillColor)
+	}
+	if s.FillGradient != nil {
+		return gradientDefinition(s.FillGradient)
+	}
+	return "", ""
+}
+
+// defineFill writes the definition of the pattern or gradient a style is
+// filled with, unless it has none or it is already defined.
+func (c *canvas) defineFill(s Style) {
+	id, markup := fillDefinition(s)
+	if len(id) == 0 || c.definitions[id] {
+		return
+	}
+	if c.definitions == nil {
+		c.definitions = make(map[string]bool)
+	}
+	c.definitions[id] = true
+	_, _ = c.w.WriteString(markup)
+}
+
 var (
 	svgEnd = []byte("</svg>")
 )
 
 func (c *canvas) End() {
+	if len(c.tables) > 0 {
+		c.writeDataTables()
+	}
 	_, _ = c.w.Write(svgEnd)
 }
 
+// writeDataTables writes the data tables as html within a foreign object that
+// is visually hidden, but still read by screen readers.
+func (c *canvas) writeDataTables() {
+	_, _ = c.w.WriteString(`<foreignObject x="0" y="0" width="1" height="1" style="overflow:hidden;opacity:0">`)
+	for _, table := range c.tables {
+		_, _ = c.w.WriteString(`<table xmlns="http://www.w3.org/1999/xhtml">`)
+		if len(table.Caption) > 0 {
+			_, _ = c.w.WriteString("<caption>" + escapeXML(table.Caption) + "</caption>")
+		}
+		if len(table.Headers) > 0 {
+			_, _ = c.w.WriteString("<thead><tr>")
+			for _, header := range table.Headers {
+				_, _ = c.w.WriteString(`<th scope="col">` + escapeXML(header) + "</th>")
+			}
+			_, _ = c.w.WriteString("</tr></thead>")
+		}
+		_, _ = c.w.WriteString("<tbody>")
+		for _, row := range table.Rows {
+			_, _ = c.w.WriteString("<tr>")
+			for _, cell := range row {
+				_, _ = c.w.WriteString("<td>" + escapeXML(cell) + "</td>")
+			}
+			_, _ = c.w.WriteString("</tr>")
+		}
+		_, _ = c.w.WriteString("</tbody></table>")
+	}
+	_, _ = c.w.WriteString("</foreignObject>")
+}
+
+// Save writes the finished svg to a writer, inserting the accessibility
+// metadata at the start of the document.
+// The image is given the img role, so it is read as a whole, unless it has
+// data tables, which the figure role lets screen readers navigate.
+func (c *canvas) Save(w io.Writer) error {
+	raw := c.w.Bytes()
+	if c.bodyStart == 0 {
+		_, err := w.Write(raw)
+		return err
+	}
+
+	role := ` role="img"`
+	if len(c.tables) > 0 {
+		role = ` role="figure"`
+	}
+	var metadata string
+	if len(c.title) > 0 {
+		metadata += "<title>" + escapeXML(c.title) + "</title>"
+	}
+	if len(c.description) > 0 {
+		metadata += "<desc>" + escapeXML(c.description) + "</desc>"
+	}
+
+	for _, chunk := range [][]byte{
+		raw[:c.rootEnd],
+		[]byte(role),
+		raw[c.rootEnd:c.bodyStart],
+		[]byte(metadata),
+		raw[c.bodyStart:],
+	} {
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // getStrokeDashArray returns the stroke-dasharray property of a style.
 func (*canvas) getStrokeDashArray(s Style) string {
 	if len(s.StrokeDashArray) > 0 {
@@ -436,9 +853,10 @@ func (*canvas) getStrokeDashArray(s Style) string {
 func (*canvas) getFontFace(s Style) string {
 	family := "sans-serif"
 	if s.GetFont() != nil {
-		name := s.GetFont().Name(truetype.NameIDFontFamily)
+		// quotes would end the css string early, so they are dropped.
+		name := strings.NewReplacer(`'`, "", `"`, "").Replace(s.GetFont().Name(truetype.NameIDFontFamily))
 		if len(name) != 0 {
-			family = `'` + name + `', ` + family
+			family = `'` + escapeXML(name) + `', ` + family
 		}
 	}
 	return "font-family:" + family
@@ -453,18 +871,24 @@ func (c *canvas) styleAsSVG(s Style) string {
 
 	if s.ClassName != "" {
 		var classes []string
-		classes = append(classes, s.ClassName)
+		classes = append(classes, escapeXML(s.ClassName))
 		if !sc.IsZero() {
 			classes = append(classes, "stroke")
 		}
-		if !fc.IsZero() {
+		fillID, _ := fillDefinition(s)
+		if !fc.IsZero() || len(fillID) > 0 {
 			classes = append(classes, "fill")
 		}
 		if fs != 0 || s.Font != nil {
 			classes = append(classes, "text")
 		}
 
-		return "class=\"" + strings.Join(classes, " ") + "\""
+		output := "class=\"" + strings.Join(classes, " ") + "\""
+		if len(fillID) > 0 {
+			// a presentation attribute, so stylesheets can still override it.
+			output += ` fill="url(#` + fillID + `)"`
+		}
+		return output
 	}
 
 	var pieces []string
@@ -484,6 +908,9 @@ func (c *canvas) styleAsSVG(s Style) string {
 	switch {
 	case !fnc.IsZero():
 		pieces = append(pieces, "fill:"+fnc.String())
+	case s.FillPattern != nil || s.FillGradient != nil:
+		id, _ := fillDefinition(s)
+		pieces = append(pieces, "fill:url(#"+id+")")
 	case !fc.IsZero():
 		pieces = append(pieces, "fill:"+fc.String())
 	default:
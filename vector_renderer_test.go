@@ -54,6 +54,28 @@ func TestVectorRendererMeasureText(t *testing.T) {
 	testutil.AssertEqual(t, 15, tb.Height())
 }
 
+func TestVectorRendererMeasureTextSizes(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	measure := func(fontSize float64) int {
+		vr, err := SVG(100, 100)
+		testutil.AssertNil(t, err)
+
+		vr.SetDPI(DefaultDPI)
+		vr.SetFont(f)
+		vr.SetFontSize(fontSize)
+		return vr.MeasureText("Measure").Width()
+	}
+
+	// the glyph advances cached for one size are not reused for another.
+	small := measure(10.0)
+	large := measure(20.0)
+	testutil.AssertTrue(t, large > small, small, large)
+}
+
 func TestCanvasStyleSVG(t *testing.T) {
 	// replaced new assertions helper
 
@@ -118,3 +140,21 @@ func TestCanvasCustomInlineStylesheetWithNonce(t *testing.T) {
 
 	testutil.AssertContains(t, b.String(), fmt.Sprintf(`<style type="text/css" nonce="%s"><![CDATA[%s]]></style>`, canvas.nonce, canvas.css))
 }
+
+func TestCanvasSaveMetadataBeforeStylesheet(t *testing.T) {
+	b := bytebufferpool.Get()
+	defer bytebufferpool.Put(b)
+
+	canvas := &canvas{
+		w:           b,
+		css:         ".background { fill: red }",
+		title:       "Test",
+		description: "Chart",
+	}
+	canvas.Start(200, 200)
+
+	// the title and description are the first children of the root element.
+	saved := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, canvas.Save(saved))
+	testutil.AssertContains(t, saved.String(), `height="200" role="img">`+"\n"+`<title>Test</title><desc>Chart</desc><style type="text/css">`)
+}
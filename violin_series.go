@@ -0,0 +1,209 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*ViolinSeries)(nil)
+	_ CategoriesProvider  = (*ViolinSeries)(nil)
+	_ ValueBoundsProvider = (*ViolinSeries)(nil)
+)
+
+// ViolinSeries draws the distribution of each of its groups as a violin, a
+// mirrored gaussian kernel density estimate of its samples between the
+// smallest and largest, with a line at the median.
+//
+// The violins share a scale, so wider is denser across groups. The series
+// works with a CategoryRange x axis, which takes its categories from the
+// group labels, or a numeric x axis.
+type ViolinSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Groups []DistributionGroup
+
+	// Bandwidth is the standard deviation of the kernel; if unset it is
+	// chosen for each group by Silverman's rule of thumb.
+	Bandwidth float64
+	// ViolinWidth is the fraction of the space for each group that its
+	// widest violin fills.
+	ViolinWidth float64
+}
+
+// GetName returns the name of the series.
+func (vs ViolinSeries) GetName() string {
+	return vs.Name
+}
+
+// GetStyle returns the series style.
+func (vs ViolinSeries) GetStyle() Style {
+	return vs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (vs ViolinSeries) GetYAxis() YAxisType {
+	return vs.YAxis
+}
+
+// GetCategories returns the labels of the groups in order.
+func (vs ViolinSeries) GetCategories() []string {
+	return distributionGroups(vs.Groups).categories()
+}
+
+// GetViolinWidth returns the fraction of the space for each group the widest violin fills.
+func (vs ViolinSeries) GetViolinWidth() float64 {
+	if vs.ViolinWidth <= 0 {
+		return DefaultCategoryBandFill
+	}
+	return vs.ViolinWidth
+}
+
+// Len returns the number of groups.
+func (vs ViolinSeries) Len() int {
+	return len(vs.Groups)
+}
+
+// GetValueBounds returns the bounds of the groups and their samples.
+func (vs ViolinSeries) GetValueBounds() (minx, maxx, miny, maxy float64) {
+	return distributionGroups(vs.Groups).valueBounds()
+}
+
+// GetBandwidth returns the kernel bandwidth for the samples of a group.
+func (vs ViolinSeries) GetBandwidth(samples Seq) float64 {
+	if vs.Bandwidth > 0 {
+		return vs.Bandwidth
+	}
+	// Silverman's rule of thumb, with fallbacks for samples with no spread.
+	spread := samples.StdDev()
+	if iqr := (samples.Percentile(0.75) - samples.Percentile(0.25)) / 1.34; iqr > 0 {
+		spread = math.Min(spread, iqr)
+	}
+	if spread <= 0 {
+		return 1
+	}
+	return 0.9 * spread * math.Pow(float64(samples.Len()), -0.2)
+}
+
+// GetDensity returns the estimated density of the samples of a group at
+// DefaultViolinResolution evenly spaced values from the smallest sample to
+// the largest.
+func (vs ViolinSeries) GetDensity(index int) (values, densities []float64) {
+	samples := distributionGroups(vs.Groups).samples(index)
+	if samples.Len() == 0 {
+		return
+	}
+	min, max := samples.MinMax()
+	bandwidth := vs.GetBandwidth(samples)
+
+	steps := DefaultViolinResolution
+	if min == max {
+		steps = 1
+	}
+	values = make([]float64, steps)
+	densities = make([]float64, steps)
+	norm := 1 / (float64(samples.Len()) * bandwidth * math.Sqrt(2*math.Pi))
+	for step := range values {
+		v := min
+		if steps > 1 {
+			v = min + (max-min)*float64(step)/float64(steps-1)
+		}
+		var density float64
+		samples.Each(func(_ int, sample float64) {
+			u := (v - sample) / bandwidth
+			density += math.Exp(-u * u / 2)
+		})
+		values[step] = v
+		densities[step] = density * norm
+	}
+	return
+}
+
+// Render renders the series.
+func (vs ViolinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := vs.Style.InheritFrom(vs.violinStyleDefaults(defaults))
+	groups := distributionGroups(vs.Groups)
+	sp := newSeriesPath(r, canvasBox, xrange, yrange)
+
+	values := make([][]float64, len(groups))
+	densities := make([][]float64, len(groups))
+	var maxDensity float64
+	for index := range groups {
+		values[index], densities[index] = vs.GetDensity(index)
+		for _, density := range densities[index] {
+			maxDensity = math.Max(maxDensity, density)
+		}
+	}
+	if maxDensity == 0 {
+		return
+	}
+	scale := groups.width(xrange, vs.GetViolinWidth()) / 2 / maxDensity
+
+	for index := range groups {
+		if len(values[index]) == 0 {
+			continue
+		}
+		x := sp.X(groups.center(xrange, index))
+
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		if len(values[index]) == 1 {
+			// samples with no spread are a single line.
+			halfWidth := densities[index][0] * scale
+			sp.MoveTo(x-halfWidth, sp.Y(values[index][0]))
+			sp.LineTo(x+halfWidth, sp.Y(values[index][0]))
+			r.Stroke()
+			r.ResetStyle()
+			continue
+		}
+
+		// up the right side and back down the left.
+		sp.MoveTo(x+densities[index][0]*scale, sp.Y(values[index][0]))
+		for step := 1; step < len(values[index]); step++ {
+			sp.LineTo(x+densities[index][step]*scale, sp.Y(values[index][step]))
+		}
+		for step := len(values[index]) - 1; step >= 0; step-- {
+			sp.LineTo(x-densities[index][step]*scale, sp.Y(values[index][step]))
+		}
+		r.Close()
+		r.FillStroke()
+		r.ResetStyle()
+
+		median := groups.samples(index).Median()
+		halfWidth := vs.densityAt(values[index], densities[index], median) * scale
+		style.GetStrokeOptions().WriteToRenderer(r)
+		sp.MoveTo(x-halfWidth, sp.Y(median))
+		sp.LineTo(x+halfWidth, sp.Y(median))
+		r.Stroke()
+		r.ResetStyle()
+	}
+}
+
+// densityAt interpolates the density at a value between the evaluated ones.
+func (vs ViolinSeries) densityAt(values, densities []float64, v float64) float64 {
+	for step := 1; step < len(values); step++ {
+		if v <= values[step] {
+			t := (v - values[step-1]) / (values[step] - values[step-1])
+			return densities[step-1] + (densities[step]-densities[step-1])*t
+		}
+	}
+	return densities[len(densities)-1]
+}
+
+func (vs ViolinSeries) violinStyleDefaults(defaults Style) Style {
+	return Style{
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: defaults.StrokeWidth,
+		FillColor:   defaults.StrokeColor.WithAlpha(DefaultDistributionFillAlpha),
+	}
+}
+
+// Validate validates the series.
+func (vs ViolinSeries) Validate() error {
+	if !distributionGroups(vs.Groups).hasSamples() {
+		return fmt.Errorf("violin series requires groups with values")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/userstyles-world/go-chart/v2/testutil"
+)
+
+func TestViolinSeriesGetDensity(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := ViolinSeries{Groups: testDistributionGroups(), Bandwidth: 1}
+
+	values, densities := vs.GetDensity(0)
+	testutil.AssertLen(t, values, DefaultViolinResolution)
+	testutil.AssertLen(t, densities, DefaultViolinResolution)
+	testutil.AssertEqual(t, 1.0, values[0])
+	testutil.AssertEqual(t, 12.0, values[len(values)-1])
+
+	// the density integrates to about one over the samples, less the tails.
+	var area float64
+	for step := 1; step < len(values); step++ {
+		area += (densities[step-1] + densities[step]) / 2 * (values[step] - values[step-1])
+	}
+	testutil.AssertInDelta(t, 0.85, area, 0.05)
+
+	// a single sample is a normal curve around it.
+	vs.Groups = []DistributionGroup{{Values: []float64{0}}}
+	values, densities = vs.GetDensity(0)
+	testutil.AssertEqual(t, []float64{0}, values)
+	testutil.AssertInDelta(t, 1/math.Sqrt(2*math.Pi), densities[0], 0.000001)
+
+	vs.Groups = []DistributionGroup{{}}
+	values, densities = vs.GetDensity(0)
+	testutil.AssertEmpty(t, values)
+	testutil.AssertEmpty(t, densities)
+}
+
+func TestViolinSeriesGetBandwidth(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := ViolinSeries{}
+	samples := ValueSequence(1, 2, 3, 4, 5)
+	// the standard deviation is smaller than the scaled interquartile range.
+	testutil.AssertInDelta(t, 0.9*math.Sqrt(2)*math.Pow(5, -0.2), vs.GetBandwidth(samples), 0.000001)
+	testutil.AssertEqual(t, 1.0, vs.GetBandwidth(ValueSequence(2, 2, 2)))
+
+	vs.Bandwidth = 0.5
+	testutil.AssertEqual(t, 0.5, vs.GetBandwidth(samples))
+}
+
+func TestViolinSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	xrange := &ContinuousRange{Min: 0, Max: 5, Domain: 200}
+	yrange := &ContinuousRange{Min: 0, Max: 20, Domain: 200}
+	vs := ViolinSeries{Groups: testDistributionGroups()}
+
+	r, err := SVG(200, 200)
+	testutil.AssertNil(t, err)
+	vs.Render(r, Box{Right: 200, Bottom: 200}, xrange, yrange, Style{StrokeColor: ColorBlue, StrokeWidth: 1})
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(buffer))
+	svg := buffer.String()
+
+	// a closed outline for each group with samples, and a median line.
+	testutil.AssertEqual(t, 2, strings.Count(svg, "Z"))
+	testutil.AssertContains(t, svg, "fill:rgba(0,116,217,0.4)")
+	testutil.AssertContains(t, svg, ` 170L `)
+}
+
+func TestViolinSeriesChart(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, xrange := range []Range{&CategoryRange{}, nil} {
+		c := Chart{
+			XAxis:  XAxis{Range: xrange},
+			Series: []Series{ViolinSeries{Groups: testDistributionGroups()}},
+		}
+
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, c.Render(SVG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+
+		buffer.Reset()
+		testutil.AssertNil(t, c.Render(PNG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
+
+func TestViolinSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ViolinSeries{}.Validate())
+	testutil.AssertNil(t, ViolinSeries{Groups: testDistributionGroups()}.Validate())
+}
@@ -0,0 +1,29 @@
+package chart
+
+// ZOrder is an enum for where a series draws relative to the other series.
+type ZOrder int
+
+const (
+	// ZOrderUnset is the unset state for z-order, which draws in series order.
+	ZOrderUnset ZOrder = 0
+	// ZOrderBehind draws before, and so behind, the other series.
+	ZOrderBehind ZOrder = 1
+	// ZOrderFront draws after, and so in front of, the other series.
+	ZOrderFront ZOrder = 2
+)
+
+// ZOrderProvider is a series that chooses where it draws among the others.
+type ZOrderProvider interface {
+	GetZOrder() ZOrder
+}
+
+// getZOrder returns the z-order of a series, treating unknown values as unset.
+func getZOrder(s Series) ZOrder {
+	if zop, isZOrderProvider := s.(ZOrderProvider); isZOrderProvider {
+		switch zOrder := zop.GetZOrder(); zOrder {
+		case ZOrderBehind, ZOrderFront:
+			return zOrder
+		}
+	}
+	return ZOrderUnset
+}